@@ -0,0 +1,120 @@
+package main
+
+import (
+	"context"
+	"errors"
+
+	"github.com/TikTokTechImmersion/assignment_demo_2023/http-server/kitex_gen/rpc"
+	"github.com/TikTokTechImmersion/assignment_demo_2023/http-server/proto_gen/api"
+	"github.com/cloudwego/hertz/pkg/app"
+	"github.com/cloudwego/hertz/pkg/protocol/consts"
+)
+
+func register(ctx context.Context, c *app.RequestContext) {
+	var req api.RegisterRequest
+	err := c.Bind(&req)
+	if err != nil {
+		writeError(c, rpc.ErrorCode_INVALID_ARGUMENT, "Failed to parse request body: %v", err)
+		return
+	}
+	resp, err := authCli.Register(ctx, &rpc.RegisterRequest{
+		User:     req.User,
+		Password: req.Password,
+	})
+	if err != nil {
+		writeCallError(c, err)
+	} else if resp.Code != 0 {
+		writeRPCError(c, resp.Code, resp.Msg)
+	} else {
+		c.JSON(consts.StatusOK, &api.RegisterResponse{})
+	}
+}
+
+func login(ctx context.Context, c *app.RequestContext) {
+	var req api.LoginRequest
+	err := c.Bind(&req)
+	if err != nil {
+		writeError(c, rpc.ErrorCode_INVALID_ARGUMENT, "Failed to parse request body: %v", err)
+		return
+	}
+	resp, err := authCli.Login(ctx, &rpc.LoginRequest{
+		User:     req.User,
+		Password: req.Password,
+	})
+	if err != nil {
+		writeCallError(c, err)
+	} else if resp.Code != 0 {
+		writeRPCError(c, resp.Code, resp.Msg)
+	} else {
+		writeTokens(c, resp.Tokens)
+	}
+}
+
+func refreshToken(ctx context.Context, c *app.RequestContext) {
+	var req api.RefreshTokenRequest
+	err := c.Bind(&req)
+	if err != nil {
+		writeError(c, rpc.ErrorCode_INVALID_ARGUMENT, "Failed to parse request body: %v", err)
+		return
+	}
+	resp, err := authCli.RefreshToken(ctx, &rpc.RefreshTokenRequest{RefreshToken: req.RefreshToken})
+	if err != nil {
+		writeCallError(c, err)
+	} else if resp.Code != 0 {
+		writeRPCError(c, resp.Code, resp.Msg)
+	} else {
+		writeTokens(c, resp.Tokens)
+	}
+}
+
+func logout(ctx context.Context, c *app.RequestContext) {
+	var req api.LogoutRequest
+	err := c.Bind(&req)
+	if err != nil {
+		writeError(c, rpc.ErrorCode_INVALID_ARGUMENT, "Failed to parse request body: %v", err)
+		return
+	}
+	resp, err := authCli.Logout(ctx, &rpc.LogoutRequest{RefreshToken: req.RefreshToken})
+	if err != nil {
+		writeCallError(c, err)
+	} else if resp.Code != 0 {
+		writeRPCError(c, resp.Code, resp.Msg)
+	} else {
+		c.JSON(consts.StatusOK, &api.LogoutResponse{})
+	}
+}
+
+// writeTokens responds with tokens, which must not be cached.
+func writeTokens(c *app.RequestContext, tokens *rpc.Tokens) {
+	c.Header("Cache-Control", "no-store")
+	c.JSON(consts.StatusOK, &api.TokenResponse{
+		AccessToken:  tokens.GetAccessToken(),
+		RefreshToken: tokens.GetRefreshToken(),
+		ExpiresIn:    tokens.GetExpiresIn(),
+		TokenType:    "Bearer",
+	})
+}
+
+// fetchKeySet returns the key set verifying the access tokens issued by the
+// AuthService.
+func fetchKeySet(ctx context.Context) ([]byte, error) {
+	resp, err := authCli.GetKeySet(ctx, rpc.NewGetKeySetRequest())
+	if err != nil {
+		return nil, err
+	} else if resp.Code != 0 {
+		return nil, errors.New(resp.Msg)
+	}
+	return []byte(resp.GetKeySet()), nil
+}
+
+// fetchRevokedSessions returns the IDs of the sessions revoked by the
+// AuthService while their access tokens may still be valid.
+func fetchRevokedSessions(ctx context.Context) ([]string, error) {
+	resp, err := authCli.RevokedSessions(ctx, rpc.NewRevokedSessionsRequest())
+	if err != nil {
+		return nil, err
+	} else if resp.Code != 0 {
+		return nil, errors.New(resp.Msg)
+	}
+	return resp.Sessions, nil
+}
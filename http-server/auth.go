@@ -0,0 +1,396 @@
+package main
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/TikTokTechImmersion/assignment_demo_2023/http-server/kitex_gen/rpc"
+	"github.com/cloudwego/hertz/pkg/app"
+	"github.com/cloudwego/hertz/pkg/common/hlog"
+	"github.com/golang-jwt/jwt/v4"
+	"golang.org/x/sync/singleflight"
+)
+
+// auth validates the bearer tokens of requests.
+var auth *authenticator
+
+// keySetRefreshInterval bounds how often the key set is fetched again from the
+// AuthService, when tokens are signed by unknown keys.
+const keySetRefreshInterval = 30 * time.Second
+
+// revocationRefreshInterval is how often the revoked sessions are fetched
+// from the AuthService, so that access tokens are rejected at most that long
+// after their session is revoked.
+const revocationRefreshInterval = 5 * time.Second
+
+// errKeySetUnavailable is returned when the key set cannot be fetched.
+var errKeySetUnavailable = errors.New("key set is unavailable")
+
+// callerKey is the key of the user making a request in its context.
+const callerKey = "caller"
+
+// publicPaths are the routes served without a bearer token. Blob downloads
+// are authorized by their signed URLs instead, so that browsers can load
+// them as images.
+var publicPaths = map[string]bool{
+	"/ping":              true,
+	"/api/blob/:key":     true,
+	"/api/auth/register": true,
+	"/api/auth/login":    true,
+	"/api/auth/refresh":  true,
+	"/api/auth/logout":   true,
+}
+
+// queryTokenPaths are the routes also taking the bearer token from the
+// access_token query parameter, as browsers cannot set headers on
+// WebSockets and EventSources.
+var queryTokenPaths = map[string]bool{
+	"/api/ws":     true,
+	"/api/stream": true,
+}
+
+// authenticator validates JWTs against a set of keys. The caller of a
+// request is the subject of its token.
+type authenticator struct {
+	issuer   string // required issuer, if set
+	audience string // required audience, if set
+	// fetch returns the key set, nil if it is fixed.
+	fetch     func(ctx context.Context) ([]byte, error)
+	refreshes singleflight.Group
+
+	mu        sync.Mutex
+	keys      map[string]*jwk // by key ID
+	fetchedAt time.Time
+	revoked   map[string]bool // IDs of the revoked sessions
+}
+
+// accessClaims are the claims of access tokens. Tokens issued by the
+// AuthService carry the ID of their session.
+type accessClaims struct {
+	jwt.RegisteredClaims
+	Session string `json:"sid,omitempty"`
+}
+
+// jwk is a verification key of a JSON Web Key Set, RFC 7517.
+type jwk struct {
+	Kty string `json:"kty"`
+	Kid string `json:"kid"`
+	Alg string `json:"alg"`
+	Use string `json:"use"`
+	K   string `json:"k"`   // oct
+	N   string `json:"n"`   // RSA
+	E   string `json:"e"`   // RSA
+	Crv string `json:"crv"` // EC
+	X   string `json:"x"`   // EC
+	Y   string `json:"y"`   // EC
+
+	key interface{} // []byte, *rsa.PublicKey or *ecdsa.PublicKey
+}
+
+// loadAuthenticator returns an authenticator with the JSON Web Key Set in
+// the file at path.
+func loadAuthenticator(path, issuer, audience string) (*authenticator, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	keys, err := parseKeySet(data)
+	if err != nil {
+		return nil, fmt.Errorf("invalid key set %s: %w", path, err)
+	}
+	return &authenticator{keys: keys, issuer: issuer, audience: audience}, nil
+}
+
+// newRemoteAuthenticator returns an authenticator with the JSON Web Key Set
+// returned by fetch, fetched again when tokens signed by unknown keys come
+// up, as keys are rotated.
+func newRemoteAuthenticator(fetch func(ctx context.Context) ([]byte, error), issuer, audience string) *authenticator {
+	return &authenticator{fetch: fetch, issuer: issuer, audience: audience}
+}
+
+// parseKeySet returns the keys of a JSON Web Key Set by ID. Symmetric
+// ("oct"), RSA and EC keys are supported.
+func parseKeySet(data []byte) (map[string]*jwk, error) {
+	var set struct {
+		Keys []*jwk `json:"keys"`
+	}
+	if err := json.Unmarshal(data, &set); err != nil {
+		return nil, err
+	}
+	keys := make(map[string]*jwk)
+	for i, k := range set.Keys {
+		if k.Use != "" && k.Use != "sig" {
+			continue
+		}
+		if err := k.parse(); err != nil {
+			return nil, fmt.Errorf("invalid key %d: %w", i, err)
+		}
+		if _, ok := keys[k.Kid]; ok {
+			return nil, fmt.Errorf("duplicate key ID %q", k.Kid)
+		}
+		keys[k.Kid] = k
+	}
+	if len(keys) == 0 {
+		return nil, errors.New("no signing keys")
+	}
+	return keys, nil
+}
+
+// parse decodes the key material of k.
+func (k *jwk) parse() error {
+	switch k.Kty {
+	case "oct":
+		b, err := base64.RawURLEncoding.DecodeString(k.K)
+		if err != nil {
+			return err
+		} else if len(b) < 32 {
+			return errors.New("symmetric keys must be at least 256 bits")
+		}
+		k.key = b
+	case "RSA":
+		n, err := decodeBigInt(k.N)
+		if err != nil {
+			return err
+		}
+		e, err := decodeBigInt(k.E)
+		if err != nil {
+			return err
+		} else if !e.IsInt64() || e.Int64() > 1<<31-1 {
+			return errors.New("RSA exponent is too large")
+		}
+		k.key = &rsa.PublicKey{N: n, E: int(e.Int64())}
+	case "EC":
+		var curve elliptic.Curve
+		switch k.Crv {
+		case "P-256":
+			curve = elliptic.P256()
+		case "P-384":
+			curve = elliptic.P384()
+		case "P-521":
+			curve = elliptic.P521()
+		default:
+			return fmt.Errorf("unsupported curve %q", k.Crv)
+		}
+		x, err := decodeBigInt(k.X)
+		if err != nil {
+			return err
+		}
+		y, err := decodeBigInt(k.Y)
+		if err != nil {
+			return err
+		}
+		if !curve.IsOnCurve(x, y) {
+			return errors.New("EC point is not on the curve")
+		}
+		k.key = &ecdsa.PublicKey{Curve: curve, X: x, Y: y}
+	default:
+		return fmt.Errorf("unsupported key type %q", k.Kty)
+	}
+	return nil
+}
+
+func decodeBigInt(s string) (*big.Int, error) {
+	b, err := base64.RawURLEncoding.DecodeString(s)
+	if err != nil {
+		return nil, err
+	}
+	return new(big.Int).SetBytes(b), nil
+}
+
+// verify returns the caller authenticated by token.
+func (a *authenticator) verify(token string) (string, error) {
+	var claims accessClaims
+	_, err := jwt.ParseWithClaims(token, &claims, a.key)
+	if err != nil {
+		return "", err
+	}
+	// Tokens without an expiry would be valid forever.
+	if claims.ExpiresAt == nil {
+		return "", errors.New("token has no expiry")
+	}
+	if a.issuer != "" && !claims.VerifyIssuer(a.issuer, true) {
+		return "", errors.New("token has an invalid issuer")
+	}
+	if a.audience != "" && !claims.VerifyAudience(a.audience, true) {
+		return "", errors.New("token has an invalid audience")
+	}
+	if claims.Subject == "" {
+		return "", errors.New("token has no subject")
+	}
+	a.mu.Lock()
+	revoked := claims.Session != "" && a.revoked[claims.Session]
+	a.mu.Unlock()
+	if revoked {
+		return "", errors.New("session is revoked")
+	}
+	return claims.Subject, nil
+}
+
+// key returns the key verifying token, which must have been signed with an
+// algorithm of the type of the key, so that a public key cannot be used as
+// a symmetric one.
+func (a *authenticator) key(token *jwt.Token) (interface{}, error) {
+	kid, _ := token.Header["kid"].(string)
+	k, err := a.lookup(kid)
+	if err != nil {
+		return nil, err
+	}
+	if k.Alg != "" && k.Alg != token.Method.Alg() {
+		return nil, fmt.Errorf("key %q does not sign with %s", kid, token.Method.Alg())
+	}
+	ok := false
+	switch token.Method.(type) {
+	case *jwt.SigningMethodHMAC:
+		_, ok = k.key.([]byte)
+	case *jwt.SigningMethodRSA, *jwt.SigningMethodRSAPSS:
+		_, ok = k.key.(*rsa.PublicKey)
+	case *jwt.SigningMethodECDSA:
+		_, ok = k.key.(*ecdsa.PublicKey)
+	}
+	if !ok {
+		return nil, fmt.Errorf("key %q does not sign with %s", kid, token.Method.Alg())
+	}
+	return k.key, nil
+}
+
+// lookup returns the key with ID kid, fetching the key set again if it is
+// unknown and the set was not fetched recently. Until a key set is fetched,
+// lookups fail with errKeySetUnavailable.
+func (a *authenticator) lookup(kid string) (*jwk, error) {
+	a.mu.Lock()
+	k, ok := a.keys[kid]
+	fresh := time.Since(a.fetchedAt) < keySetRefreshInterval
+	a.mu.Unlock()
+	if ok {
+		return k, nil
+	} else if a.fetch == nil || fresh {
+		return nil, fmt.Errorf("unknown key ID %q", kid)
+	}
+	// Concurrent lookups share a single fetch, made without holding mu so
+	// that requests signed by known keys are not held up by it.
+	if _, err, _ := a.refreshes.Do("", a.refresh); err != nil {
+		return nil, fmt.Errorf("%w: %v", errKeySetUnavailable, err)
+	}
+	a.mu.Lock()
+	k, ok = a.keys[kid]
+	a.mu.Unlock()
+	if !ok {
+		return nil, fmt.Errorf("unknown key ID %q", kid)
+	}
+	return k, nil
+}
+
+// refresh fetches the key set again. Failed fetches are retried by the next
+// lookup, as they leave the time of the last fetch unchanged.
+func (a *authenticator) refresh() (interface{}, error) {
+	data, err := a.fetch(context.Background())
+	if err != nil {
+		return nil, err
+	}
+	keys, err := parseKeySet(data)
+	if err != nil {
+		return nil, err
+	}
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	a.keys = keys
+	a.fetchedAt = time.Now()
+	return nil, nil
+}
+
+// watchRevocations fetches the IDs of the revoked sessions with fetch every
+// interval until ctx is done. Failed fetches are logged and keep the sessions
+// fetched last.
+func (a *authenticator) watchRevocations(ctx context.Context, fetch func(ctx context.Context) ([]string, error), interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		if err := a.fetchRevocations(ctx, fetch); err != nil {
+			hlog.CtxErrorf(ctx, "failed to fetch the revoked sessions: %v", err)
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// fetchRevocations replaces the revoked sessions with the ones returned by
+// fetch.
+func (a *authenticator) fetchRevocations(ctx context.Context, fetch func(ctx context.Context) ([]string, error)) error {
+	ids, err := fetch(ctx)
+	if err != nil {
+		return err
+	}
+	revoked := make(map[string]bool, len(ids))
+	for _, id := range ids {
+		revoked[id] = true
+	}
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	a.revoked = revoked
+	return nil
+}
+
+// authenticate rejects the requests to non-public routes without a valid
+// bearer token, and sets the caller of the others.
+func authenticate(ctx context.Context, c *app.RequestContext) {
+	if publicPaths[c.FullPath()] {
+		c.Next(ctx)
+		return
+	}
+	token := ""
+	if h := string(c.GetHeader("Authorization")); len(h) > 7 && strings.EqualFold(h[:7], "Bearer ") {
+		token = strings.TrimSpace(h[7:])
+	} else if queryTokenPaths[c.FullPath()] {
+		token = c.Query("access_token")
+	}
+	if token == "" {
+		c.Header("WWW-Authenticate", `Bearer`)
+		abortError(c, rpc.ErrorCode_UNAUTHENTICATED, "Bearer token is required")
+		return
+	}
+	caller, err := auth.verify(token)
+	if errors.Is(err, errKeySetUnavailable) {
+		abortError(c, rpc.ErrorCode_UNAVAILABLE, err.Error())
+		return
+	} else if err != nil {
+		c.Header("WWW-Authenticate", `Bearer error="invalid_token"`)
+		abortError(c, rpc.ErrorCode_UNAUTHENTICATED, "Invalid bearer token: %v", err)
+		return
+	}
+	c.Set(callerKey, caller)
+	c.Next(ctx)
+}
+
+// asCaller checks that *user, the user a request is made as, is its caller,
+// setting it if empty. Otherwise it rejects the request and returns false.
+func asCaller(c *app.RequestContext, user *string) bool {
+	if err := actAs(c.GetString(callerKey), user); err != nil {
+		writeError(c, rpc.ErrorCode_PERMISSION_DENIED, err.Error())
+		return false
+	}
+	return true
+}
+
+// actAs checks that *user is caller, setting it if empty.
+func actAs(caller string, user *string) error {
+	if *user == "" {
+		*user = caller
+	} else if *user != caller {
+		return fmt.Errorf("cannot act as user %q when authenticated as %q", *user, caller)
+	}
+	return nil
+}
@@ -0,0 +1,190 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/TikTokTechImmersion/assignment_demo_2023/http-server/kitex_gen/rpc"
+	"github.com/cloudwego/hertz/pkg/app"
+	"github.com/cloudwego/hertz/pkg/common/ut"
+	"github.com/cloudwego/hertz/pkg/protocol/consts"
+	"github.com/golang-jwt/jwt/v4"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAuthenticator_Verify(t *testing.T) {
+	keys, err := parseKeySet(testKeySet(testKeyID, testSecret))
+	if err != nil {
+		t.Fatal(err)
+	}
+	a := &authenticator{keys: keys}
+	otherSecret := []byte("fedcba9876543210fedcba9876543210")
+	tests := []struct {
+		name    string
+		token   string
+		want    string
+		wantErr string
+	}{
+		{name: "valid", token: testToken(t, "doe", testKeyID, testSecret, time.Minute), want: "doe"},
+		{name: "expired", token: testToken(t, "doe", testKeyID, testSecret, -time.Minute), wantErr: "expired"},
+		{name: "wrong key", token: testToken(t, "doe", testKeyID, otherSecret, time.Minute), wantErr: "signature is invalid"},
+		{name: "unknown key ID", token: testToken(t, "doe", "other", testSecret, time.Minute), wantErr: `unknown key ID "other"`},
+		{name: "no subject", token: testToken(t, "", testKeyID, testSecret, time.Minute), wantErr: "no subject"},
+		{name: "malformed", token: "not.a.token", wantErr: "invalid"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := a.verify(tt.token)
+			if tt.wantErr != "" {
+				assert.ErrorContains(t, err, tt.wantErr)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestAuthenticator_Refresh(t *testing.T) {
+	var mu sync.Mutex
+	var set []byte
+	fetches := 0
+	a := newRemoteAuthenticator(func(ctx context.Context) ([]byte, error) {
+		mu.Lock()
+		defer mu.Unlock()
+		fetches++
+		if set == nil {
+			return nil, errors.New("connection refused")
+		}
+		return set, nil
+	}, "", "")
+	setKeys := func(kid string, secret []byte) {
+		mu.Lock()
+		defer mu.Unlock()
+		set = testKeySet(kid, secret)
+	}
+	token := testToken(t, "doe", testKeyID, testSecret, time.Minute)
+
+	// Failed fetches are retried by the next requests.
+	for i := 0; i < 2; i++ {
+		_, err := a.verify(token)
+		assert.True(t, errors.Is(err, errKeySetUnavailable), err)
+	}
+	assert.Equal(t, 2, fetches)
+
+	setKeys(testKeyID, testSecret)
+	user, err := a.verify(token)
+	assert.NoError(t, err)
+	assert.Equal(t, "doe", user)
+	assert.Equal(t, 3, fetches)
+
+	// Keys rotated since the last fetch are only fetched once it is stale.
+	rotated := []byte("fedcba9876543210fedcba9876543210")
+	setKeys("rotated", rotated)
+	_, err = a.verify(testToken(t, "doe", "rotated", rotated, time.Minute))
+	assert.ErrorContains(t, err, `unknown key ID "rotated"`)
+	assert.Equal(t, 3, fetches)
+
+	a.fetchedAt = a.fetchedAt.Add(-keySetRefreshInterval)
+	user, err = a.verify(testToken(t, "doe", "rotated", rotated, time.Minute))
+	assert.NoError(t, err)
+	assert.Equal(t, "doe", user)
+	assert.Equal(t, 4, fetches)
+}
+
+func TestAuthenticate(t *testing.T) {
+	e := newTestEngine(t)
+	e.GET("/test", func(ctx context.Context, c *app.RequestContext) {
+		user := c.Query("user")
+		if asCaller(c, &user) {
+			c.String(consts.StatusOK, user)
+		}
+	})
+	e.GET("/api/stream", func(ctx context.Context, c *app.RequestContext) {
+		c.String(consts.StatusOK, c.GetString(callerKey))
+	})
+	token := testToken(t, "doe", testKeyID, testSecret, time.Minute)
+	tests := []struct {
+		name       string
+		url        string
+		header     string
+		wantStatus int
+		wantBody   string
+	}{
+		{name: "caller", url: "/test", header: "Bearer " + token, wantStatus: consts.StatusOK, wantBody: "doe"},
+		{name: "as caller", url: "/test?user=doe", header: "bearer " + token, wantStatus: consts.StatusOK, wantBody: "doe"},
+		{name: "as other user", url: "/test?user=john", header: "Bearer " + token, wantStatus: consts.StatusForbidden, wantBody: rpc.ErrorCode_PERMISSION_DENIED.String()},
+		{name: "no token", url: "/test", wantStatus: consts.StatusUnauthorized, wantBody: rpc.ErrorCode_UNAUTHENTICATED.String()},
+		{name: "invalid token", url: "/test", header: "Bearer " + token + "x", wantStatus: consts.StatusUnauthorized, wantBody: "Invalid bearer token"},
+		{name: "query token", url: "/api/stream?access_token=" + token, wantStatus: consts.StatusOK, wantBody: "doe"},
+		{name: "query token elsewhere", url: "/test?access_token=" + token, wantStatus: consts.StatusUnauthorized},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var headers []ut.Header
+			if tt.header != "" {
+				headers = append(headers, ut.Header{Key: "Authorization", Value: tt.header})
+			}
+			w := performRequest(t, e, consts.MethodGet, tt.url, "", nil, headers...)
+			assert.Equal(t, tt.wantStatus, w.Code)
+			assert.Contains(t, string(w.Body.Bytes()), tt.wantBody)
+			if tt.wantStatus == consts.StatusUnauthorized {
+				assert.Contains(t, string(w.Header().Peek("WWW-Authenticate")), "Bearer")
+			}
+		})
+	}
+
+	// Requests are not rejected as unauthenticated while the key set is
+	// unavailable, but as failures that clients may retry.
+	auth = newRemoteAuthenticator(func(ctx context.Context) ([]byte, error) {
+		return nil, errors.New("connection refused")
+	}, "", "")
+	w := performRequest(t, e, consts.MethodGet, "/test", "doe", nil)
+	assert.Equal(t, consts.StatusServiceUnavailable, w.Code)
+	assert.Contains(t, string(w.Body.Bytes()), `"retryable":true`)
+}
+
+func TestAuthenticate_RevokedSessions(t *testing.T) {
+	ctx := context.Background()
+	e := newTestEngine(t)
+	e.GET("/test", func(ctx context.Context, c *app.RequestContext) {
+		c.String(consts.StatusOK, c.GetString(callerKey))
+	})
+	sessionToken := func(sid string) string {
+		token := jwt.NewWithClaims(jwt.SigningMethodHS256, &accessClaims{
+			RegisteredClaims: jwt.RegisteredClaims{Subject: "doe", ExpiresAt: jwt.NewNumericDate(time.Now().Add(time.Minute))},
+			Session:          sid,
+		})
+		token.Header["kid"] = testKeyID
+		s, err := token.SignedString(testSecret)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return s
+	}
+	get := func(token string) int {
+		return performRequest(t, e, consts.MethodGet, "/test", "", nil, ut.Header{Key: "Authorization", Value: "Bearer " + token}).Code
+	}
+	assert.Equal(t, consts.StatusOK, get(sessionToken("s1")))
+
+	revoked := []string{"s1"}
+	fetch := func(ctx context.Context) ([]string, error) {
+		if revoked == nil {
+			return nil, errors.New("connection refused")
+		}
+		return revoked, nil
+	}
+	assert.NoError(t, auth.fetchRevocations(ctx, fetch))
+	assert.Equal(t, consts.StatusUnauthorized, get(sessionToken("s1")))
+	assert.Equal(t, consts.StatusOK, get(sessionToken("s2")))
+	// Tokens issued elsewhere have no session.
+	assert.Equal(t, consts.StatusOK, get(testToken(t, "doe", testKeyID, testSecret, time.Minute)))
+
+	// Failed fetches keep the sessions fetched last.
+	revoked = nil
+	assert.Error(t, auth.fetchRevocations(ctx, fetch))
+	assert.Equal(t, consts.StatusUnauthorized, get(sessionToken("s1")))
+}
@@ -0,0 +1,160 @@
+package main
+
+import (
+	"context"
+	"time"
+
+	"github.com/TikTokTechImmersion/assignment_demo_2023/http-server/kitex_gen/rpc"
+	"github.com/TikTokTechImmersion/assignment_demo_2023/http-server/proto_gen/api"
+	"github.com/cloudwego/hertz/pkg/app"
+	"github.com/cloudwego/hertz/pkg/protocol/consts"
+)
+
+func batchSend(ctx context.Context, c *app.RequestContext) {
+	var req api.BatchSendRequest
+	err := c.Bind(&req)
+	if err != nil {
+		writeError(c, rpc.ErrorCode_INVALID_ARGUMENT, "Failed to parse request body: %v", err)
+		return
+	}
+	reqs := make([]*rpc.SendRequest, 0, len(req.Requests))
+	for i, r := range req.Requests {
+		// The whole batch is rejected once a message is rate limited, as
+		// sendMessage does. Sends with an idempotency key are left to the
+		// limits of the rpc-server.
+		if !asCaller(c, &r.Sender) || r.IdempotencyKey == "" && !limits.allowSend(c, r.Sender, r.Chat) {
+			return
+		}
+		key := r.IdempotencyKey
+		attachment, code, err := sentAttachment(ctx, r.Attachment, r.Sender)
+		if err != nil {
+			writeError(c, code, "request %d: %v", i, err)
+			return
+		}
+		reqs = append(reqs, &rpc.SendRequest{
+			Message: &rpc.Message{
+				Chat:       r.Chat,
+				Text:       r.Text,
+				Sender:     r.Sender,
+				ReplyTo:    r.ReplyTo,
+				Type:       rpc.ContentType(r.Type),
+				Attachment: attachment,
+			},
+			IdempotencyKey: &key,
+		})
+	}
+	resp, err := cli.BatchSend(ctx, &rpc.BatchSendRequest{Requests: reqs})
+	if err != nil {
+		writeCallError(c, err)
+		return
+	} else if resp.Code != 0 {
+		writeRPCError(c, resp.Code, resp.Msg)
+		return
+	}
+	// Messages rejected by rate limits can be sent again once the longest
+	// of their waits is over.
+	var wait int64
+	results := make([]*api.SendResult, 0, len(resp.Responses))
+	for _, r := range resp.Responses {
+		if r.Code == int32(rpc.ErrorCode_RATE_LIMITED) && r.GetRetryAfterMs() > wait {
+			wait = r.GetRetryAfterMs()
+		}
+		results = append(results, &api.SendResult{
+			Status:    itemStatus(r.Code),
+			Error:     itemError(r.Code, r.Msg),
+			Id:        r.GetID(),
+			SendTime:  r.GetSendTime(),
+			Code:      itemCode(r.Code),
+			Retryable: r.Code != 0 && retryable(errorCode(r.Code)),
+		})
+	}
+	if wait > 0 {
+		setRetryAfter(c, time.Duration(wait)*time.Millisecond)
+	}
+	c.JSON(consts.StatusOK, &api.BatchSendResponse{Results: results})
+}
+
+func multiPull(ctx context.Context, c *app.RequestContext) {
+	var req api.MultiPullRequest
+	err := c.Bind(&req)
+	if err != nil {
+		writeError(c, rpc.ErrorCode_INVALID_ARGUMENT, "Failed to parse request body: %v", err)
+		return
+	}
+	// The chats are only limited by the rpc-server, which tells them apart
+	// from the rejected ones.
+	if !asCaller(c, &req.User) || !limits.allowPull(c, req.User, "") {
+		return
+	}
+	cursors := make([]*rpc.ChatCursor, 0, len(req.Chats))
+	for _, chat := range req.Chats {
+		cursors = append(cursors, &rpc.ChatCursor{Chat: chat.Chat, Cursor: chat.Cursor})
+	}
+	resp, err := cli.MultiPull(ctx, &rpc.MultiPullRequest{
+		User:    req.User,
+		Chats:   cursors,
+		Limit:   &req.Limit,
+		Reverse: &req.Reverse,
+	})
+	if err != nil {
+		writeCallError(c, err)
+		return
+	} else if resp.Code != 0 {
+		if resp.Code == int32(rpc.ErrorCode_RATE_LIMITED) {
+			setRetryAfter(c, time.Duration(resp.GetRetryAfterMs())*time.Millisecond)
+		}
+		writeRPCError(c, resp.Code, resp.Msg)
+		return
+	}
+	// Chats rejected by rate limits can be pulled again once the longest of
+	// their waits is over.
+	var wait int64
+	results := make([]*api.PullResult, 0, len(resp.Responses))
+	for _, r := range resp.Responses {
+		if r.Code == int32(rpc.ErrorCode_RATE_LIMITED) && r.GetRetryAfterMs() > wait {
+			wait = r.GetRetryAfterMs()
+		}
+		messages := make([]*api.Message, 0, len(r.Messages))
+		for _, msg := range r.Messages {
+			messages = append(messages, newAPIMessage(msg))
+		}
+		results = append(results, &api.PullResult{
+			Status:     itemStatus(r.Code),
+			Error:      itemError(r.Code, r.Msg),
+			Messages:   messages,
+			HasMore:    r.GetHasMore(),
+			NextCursor: r.GetNextCursor(),
+			Code:       itemCode(r.Code),
+			Retryable:  r.Code != 0 && retryable(errorCode(r.Code)),
+		})
+	}
+	if wait > 0 {
+		setRetryAfter(c, time.Duration(wait)*time.Millisecond)
+	}
+	c.JSON(consts.StatusOK, &api.MultiPullResponse{Results: results})
+}
+
+// itemStatus returns the HTTP status of an item of a batch with response code.
+func itemStatus(code int32) int32 {
+	if code == 0 {
+		return consts.StatusOK
+	}
+	return int32(httpStatus(code))
+}
+
+// itemError returns the error message of an item of a batch, empty on success.
+func itemError(code int32, msg string) string {
+	if code == 0 {
+		return ""
+	}
+	return msg
+}
+
+// itemCode returns the name of the ErrorCode of an item of a batch, empty on
+// success.
+func itemCode(code int32) string {
+	if code == 0 {
+		return ""
+	}
+	return errorCode(code).String()
+}
@@ -0,0 +1,140 @@
+package main
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"hash"
+	"io"
+	"net/url"
+	"strconv"
+	"time"
+)
+
+var (
+	errBlobNotFound = errors.New("blob not found")
+	errBlobTooLarge = fmt.Errorf("blob is larger than %d bytes", maxBlobSize)
+)
+
+// maxBlobSize bounds the size of the uploaded blobs.
+const maxBlobSize = 25 << 20
+
+// BlobStore stores the content of the attachments uploaded to the
+// http-server. Blobs are immutable once stored.
+type BlobStore interface {
+	// Put stores the content read from r under key, along with info, and
+	// returns info completed with the size and checksum of the content.
+	Put(ctx context.Context, key string, r io.Reader, info BlobInfo) (BlobInfo, error)
+	// Stat returns the metadata of the blob stored under key, or
+	// errBlobNotFound.
+	Stat(ctx context.Context, key string) (BlobInfo, error)
+	// Open returns the content and the metadata of the blob stored under key,
+	// or errBlobNotFound. The caller closes the content.
+	Open(ctx context.Context, key string) (io.ReadCloser, BlobInfo, error)
+	// Delete removes the blob stored under key, if any.
+	Delete(ctx context.Context, key string) error
+}
+
+// BlobInfo is the metadata of a blob.
+type BlobInfo struct {
+	Name     string // file name given by the uploader
+	Owner    string // user who uploaded the blob
+	MimeType string
+	Size     int64  // unit: bytes
+	Checksum string // hex encoded SHA-256 digest of the content
+}
+
+// newBlobKey returns a random key for a new blob, which cannot be guessed.
+func newBlobKey() (string, error) {
+	b := make([]byte, 16)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(b), nil
+}
+
+// validBlobKey reports whether key is a key returned by newBlobKey.
+func validBlobKey(key string) bool {
+	if len(key) != 32 {
+		return false
+	}
+	for _, c := range key {
+		if !('0' <= c && c <= '9' || 'a' <= c && c <= 'f') {
+			return false
+		}
+	}
+	return true
+}
+
+// digestReader reads the content of a blob, computing its size and
+// checksum on the way. Reading more than maxBlobSize bytes fails with
+// errBlobTooLarge.
+type digestReader struct {
+	r    io.Reader
+	size int64
+	hash hash.Hash
+}
+
+func newDigestReader(r io.Reader) *digestReader {
+	return &digestReader{r: r, hash: sha256.New()}
+}
+
+func (d *digestReader) Read(p []byte) (int, error) {
+	n, err := d.r.Read(p)
+	d.size += int64(n)
+	d.hash.Write(p[:n])
+	if d.size > maxBlobSize {
+		return n, errBlobTooLarge
+	}
+	return n, err
+}
+
+// complete returns info with the size and checksum of the content read.
+func (d *digestReader) complete(info BlobInfo) BlobInfo {
+	info.Size, info.Checksum = d.size, hex.EncodeToString(d.hash.Sum(nil))
+	return info
+}
+
+// blobSigner signs the download URLs of blobs, so that they can only be
+// downloaded by the clients they were handed to, for a limited time.
+type blobSigner struct {
+	secret []byte
+	ttl    time.Duration
+}
+
+// url returns the signed download URL of the blob stored under key. URLs
+// expire between ttl and twice ttl from now, on multiples of ttl, so that the
+// URL of a blob stays the same for a while and can be cached by clients.
+func (s *blobSigner) url(key string) string {
+	expires := time.Now().Truncate(s.ttl).Add(2 * s.ttl).Unix()
+	return "/api/blob/" + key + "?" + url.Values{
+		"expires": {strconv.FormatInt(expires, 10)},
+		"sig":     {s.sign(key, expires)},
+	}.Encode()
+}
+
+// verify checks the expiry and signature of a download URL of the blob
+// stored under key.
+func (s *blobSigner) verify(key, expires, sig string) error {
+	t, err := strconv.ParseInt(expires, 10, 64)
+	if err != nil {
+		return fmt.Errorf("invalid expiry %q", expires)
+	}
+	if !hmac.Equal([]byte(sig), []byte(s.sign(key, t))) {
+		return errors.New("invalid signature")
+	}
+	if time.Now().Unix() > t {
+		return errors.New("download URL expired")
+	}
+	return nil
+}
+
+func (s *blobSigner) sign(key string, expires int64) string {
+	mac := hmac.New(sha256.New, s.secret)
+	fmt.Fprintf(mac, "%s\n%d", key, expires)
+	return hex.EncodeToString(mac.Sum(nil))
+}
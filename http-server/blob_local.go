@@ -0,0 +1,109 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+)
+
+// localBlobStore is a BlobStore keeping every blob in a file of a local
+// directory, next to a JSON file holding its metadata.
+type localBlobStore struct {
+	dir string
+}
+
+// NewLocalBlobStore returns a BlobStore keeping the blobs in dir, creating
+// it if needed.
+func NewLocalBlobStore(dir string) (BlobStore, error) {
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return nil, err
+	}
+	return &localBlobStore{dir: dir}, nil
+}
+
+func (s *localBlobStore) Put(ctx context.Context, key string, r io.Reader, info BlobInfo) (BlobInfo, error) {
+	if !validBlobKey(key) {
+		return BlobInfo{}, errBlobNotFound
+	}
+	d := newDigestReader(r)
+	if err := s.write(key, d); err != nil {
+		return BlobInfo{}, err
+	}
+	info = d.complete(info)
+	meta, err := json.Marshal(info)
+	if err != nil {
+		return BlobInfo{}, err
+	}
+	// The metadata is written last, blobs without metadata are not found.
+	if err := s.write(key+".json", bytes.NewReader(meta)); err != nil {
+		return BlobInfo{}, err
+	}
+	return info, nil
+}
+
+// write writes the content read from r to the file name of the directory,
+// which is left as it was if the write fails.
+func (s *localBlobStore) write(name string, r io.Reader) error {
+	f, err := os.CreateTemp(s.dir, ".upload-*")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(f.Name())
+	if _, err := io.Copy(f, r); err != nil {
+		f.Close()
+		return err
+	}
+	if err := f.Sync(); err != nil {
+		f.Close()
+		return err
+	}
+	if err := f.Close(); err != nil {
+		return err
+	}
+	return os.Rename(f.Name(), filepath.Join(s.dir, name))
+}
+
+func (s *localBlobStore) Stat(ctx context.Context, key string) (BlobInfo, error) {
+	if !validBlobKey(key) {
+		return BlobInfo{}, errBlobNotFound
+	}
+	b, err := os.ReadFile(filepath.Join(s.dir, key+".json"))
+	if errors.Is(err, fs.ErrNotExist) {
+		return BlobInfo{}, errBlobNotFound
+	} else if err != nil {
+		return BlobInfo{}, err
+	}
+	var info BlobInfo
+	err = json.Unmarshal(b, &info)
+	return info, err
+}
+
+func (s *localBlobStore) Open(ctx context.Context, key string) (io.ReadCloser, BlobInfo, error) {
+	info, err := s.Stat(ctx, key)
+	if err != nil {
+		return nil, BlobInfo{}, err
+	}
+	f, err := os.Open(filepath.Join(s.dir, key))
+	if err != nil {
+		return nil, BlobInfo{}, err
+	}
+	return f, info, nil
+}
+
+func (s *localBlobStore) Delete(ctx context.Context, key string) error {
+	if !validBlobKey(key) {
+		return nil
+	}
+	// The metadata is removed first, blobs without metadata are not found.
+	for _, name := range []string{key + ".json", key} {
+		if err := os.Remove(filepath.Join(s.dir, name)); err != nil && !errors.Is(err, fs.ErrNotExist) {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,215 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"net/url"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/TikTokTechImmersion/assignment_demo_2023/http-server/kitex_gen/rpc"
+	"github.com/TikTokTechImmersion/assignment_demo_2023/http-server/proto_gen/api"
+	"github.com/cloudwego/hertz/pkg/common/ut"
+	"github.com/cloudwego/hertz/pkg/protocol/consts"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBlobSigner(t *testing.T) {
+	s := &blobSigner{secret: []byte("secret"), ttl: time.Minute}
+	key := strings.Repeat("ab", 16)
+	u, err := url.Parse(s.url(key))
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, "/api/blob/"+key, u.Path)
+	expires, sig := u.Query().Get("expires"), u.Query().Get("sig")
+	// URLs expire between ttl and twice ttl from now.
+	t.Run("expiry", func(t *testing.T) {
+		n, err := strconv.ParseInt(expires, 10, 64)
+		assert.NoError(t, err)
+		assert.Greater(t, n, time.Now().Add(s.ttl).Unix()-1)
+		assert.LessOrEqual(t, n, time.Now().Add(2*s.ttl).Unix())
+		assert.Equal(t, s.url(key), s.url(key))
+	})
+
+	past := time.Now().Add(-time.Second).Unix()
+	// The last digit of the signature is changed to another one.
+	tampered := sig[:len(sig)-1] + "0"
+	if tampered == sig {
+		tampered = sig[:len(sig)-1] + "1"
+	}
+	tests := []struct {
+		name    string
+		signer  *blobSigner
+		key     string
+		expires string
+		sig     string
+		wantErr string
+	}{
+		{name: "valid", signer: s, key: key, expires: expires, sig: sig},
+		{name: "expired", signer: s, key: key, expires: strconv.FormatInt(past, 10), sig: s.sign(key, past), wantErr: "expired"},
+		{name: "other key", signer: s, key: strings.Repeat("cd", 16), expires: expires, sig: sig, wantErr: "invalid signature"},
+		{name: "extended expiry", signer: s, key: key, expires: expires + "0", sig: sig, wantErr: "invalid signature"},
+		{name: "tampered signature", signer: s, key: key, expires: expires, sig: tampered, wantErr: "invalid signature"},
+		{name: "no signature", signer: s, key: key, expires: expires, wantErr: "invalid signature"},
+		{name: "other secret", signer: &blobSigner{secret: []byte("other"), ttl: time.Minute}, key: key, expires: expires, sig: sig, wantErr: "invalid signature"},
+		{name: "invalid expiry", signer: s, key: key, expires: "soon", sig: sig, wantErr: "invalid expiry"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.signer.verify(tt.key, tt.expires, tt.sig)
+			if tt.wantErr != "" {
+				assert.ErrorContains(t, err, tt.wantErr)
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
+
+func TestDownloadBlob(t *testing.T) {
+	ctx := context.Background()
+	var err error
+	blobs, err = NewLocalBlobStore(t.TempDir())
+	if err != nil {
+		t.Fatal(err)
+	}
+	signer = &blobSigner{secret: []byte("secret"), ttl: time.Minute}
+	e := newTestEngine(t)
+	e.GET("/api/blob/:key", downloadBlob)
+	key, err := newBlobKey()
+	if err != nil {
+		t.Fatal(err)
+	}
+	info, err := blobs.Put(ctx, key, strings.NewReader("<svg/>"), BlobInfo{Name: "a.svg", MimeType: "image/svg+xml"})
+	assert.NoError(t, err)
+	assert.Equal(t, int64(6), info.Size)
+
+	w := performRequest(t, e, consts.MethodGet, signer.url(key), "", nil)
+	assert.Equal(t, consts.StatusOK, w.Code)
+	assert.Equal(t, "<svg/>", string(w.Body.Bytes()))
+	// SVG images could run scripts, they are downloaded rather than shown.
+	assert.Equal(t, `attachment; filename=a.svg`, string(w.Header().Peek("Content-Disposition")))
+	assert.Equal(t, strconv.Quote(info.Checksum), string(w.Header().Peek("ETag")))
+
+	w = performRequest(t, e, consts.MethodGet, strings.Replace(signer.url(key), "sig=", "sig=0", 1), "", nil)
+	assert.Equal(t, consts.StatusForbidden, w.Code)
+	other, err := newBlobKey()
+	if err != nil {
+		t.Fatal(err)
+	}
+	w = performRequest(t, e, consts.MethodGet, signer.url(other), "", nil)
+	assert.Equal(t, consts.StatusNotFound, w.Code)
+}
+
+func TestSendMessage_Attachment(t *testing.T) {
+	var err error
+	blobs, err = NewLocalBlobStore(t.TempDir())
+	if err != nil {
+		t.Fatal(err)
+	}
+	signer = &blobSigner{secret: []byte("secret"), ttl: time.Minute}
+	e := newTestEngine(t)
+	e.POST("/api/upload", upload)
+	e.POST("/api/send", sendMessage)
+	var (
+		sent       *rpc.Attachment
+		registered []string
+	)
+	cli = &fakeIMService{
+		send: func(req *rpc.SendRequest) *rpc.SendResponse {
+			sent = req.Message.Attachment
+			return &rpc.SendResponse{Code: 0, Msg: "success"}
+		},
+		registerBlob: func(req *rpc.RegisterBlobRequest) *rpc.RegisterBlobResponse {
+			registered = append(registered, req.Key)
+			return &rpc.RegisterBlobResponse{Code: 0, Msg: "success"}
+		},
+	}
+	w := performRequest(t, e, consts.MethodPost, "/api/upload?name=a.txt", "doe", []byte("hello"))
+	assert.Equal(t, consts.StatusOK, w.Code)
+	var uploaded api.UploadResponse
+	assert.NoError(t, json.Unmarshal(w.Body.Bytes(), &uploaded))
+	key := uploaded.Attachment.GetKey()
+	// Uploads are registered, so that they are deleted unless attached.
+	assert.Equal(t, []string{key}, registered)
+
+	tests := []struct {
+		name   string
+		sender string
+		key    string
+		want   int
+	}{
+		{name: "uploader", sender: "doe", key: key, want: consts.StatusOK},
+		{name: "other user", sender: "john", key: key, want: consts.StatusForbidden},
+		{name: "unknown key", sender: "doe", key: strings.Repeat("ab", 16), want: consts.StatusBadRequest},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sent = nil
+			body := []byte(`{"chat":"doe:john","type":2,"attachment":"` + tt.key + `"}`)
+			w := performRequest(t, e, consts.MethodPost, "/api/send", tt.sender, body, ut.Header{Key: "Content-Type", Value: "application/json"})
+			assert.Equal(t, tt.want, w.Code)
+			if tt.want == consts.StatusOK {
+				assert.Equal(t, "a.txt", sent.GetName())
+				assert.Equal(t, int64(5), sent.GetSize())
+			} else {
+				assert.Nil(t, sent)
+			}
+		})
+	}
+}
+
+func TestReapReleasedBlobs(t *testing.T) {
+	ctx := context.Background()
+	var err error
+	blobs, err = NewLocalBlobStore(t.TempDir())
+	if err != nil {
+		t.Fatal(err)
+	}
+	var keys []string
+	for i := 0; i < 3; i++ {
+		key, err := newBlobKey()
+		if err != nil {
+			t.Fatal(err)
+		}
+		_, err = blobs.Put(ctx, key, strings.NewReader("content"), BlobInfo{})
+		assert.NoError(t, err)
+		keys = append(keys, key)
+	}
+	// The rpc-server releases the first two blobs, one at a time, and fails
+	// once.
+	var cursors []int64
+	failing := false
+	cli = &fakeIMService{releasedBlobs: func(req *rpc.ReleasedBlobsRequest) *rpc.ReleasedBlobsResponse {
+		assert.Equal(t, "h1", req.Consumer)
+		cursors = append(cursors, req.Cursor)
+		if failing {
+			return &rpc.ReleasedBlobsResponse{Code: int32(rpc.ErrorCode_UNAVAILABLE), Msg: "unavailable"}
+		}
+		next := req.Cursor
+		resp := &rpc.ReleasedBlobsResponse{NextCursor: &next}
+		if req.Cursor < 2 {
+			next++
+			resp.Keys = keys[req.Cursor:next]
+		}
+		return resp
+	}}
+	assert.Equal(t, int64(2), reapReleasedBlobs(ctx, "h1", 0))
+	assert.Equal(t, []int64{0, 1, 2}, cursors)
+	for i, key := range keys {
+		_, err := blobs.Stat(ctx, key)
+		if i < 2 {
+			assert.ErrorIs(t, err, errBlobNotFound)
+		} else {
+			assert.NoError(t, err)
+		}
+	}
+	// Blobs deleted already are deleted again without failing.
+	assert.NoError(t, blobs.Delete(ctx, keys[0]))
+
+	failing = true
+	assert.Equal(t, int64(2), reapReleasedBlobs(ctx, "h1", 2))
+}
@@ -0,0 +1,88 @@
+package main
+
+import (
+	"context"
+
+	"github.com/TikTokTechImmersion/assignment_demo_2023/http-server/kitex_gen/rpc"
+	"github.com/TikTokTechImmersion/assignment_demo_2023/http-server/proto_gen/api"
+	"github.com/cloudwego/hertz/pkg/app"
+	"github.com/cloudwego/hertz/pkg/protocol/consts"
+)
+
+func editMessage(ctx context.Context, c *app.RequestContext) {
+	var req api.EditMessageRequest
+	err := c.Bind(&req)
+	if err != nil {
+		writeError(c, rpc.ErrorCode_INVALID_ARGUMENT, "Failed to parse request body: %v", err)
+		return
+	}
+	if !asCaller(c, &req.User) {
+		return
+	}
+	resp, err := cli.EditMessage(ctx, &rpc.EditMessageRequest{
+		Chat: req.Chat,
+		ID:   req.Id,
+		User: req.User,
+		Text: req.Text,
+	})
+	if err != nil {
+		writeCallError(c, err)
+	} else if resp.Code != 0 {
+		writeRPCError(c, resp.Code, resp.Msg)
+	} else {
+		c.JSON(consts.StatusOK, &api.EditMessageResponse{Message: newAPIMessage(resp.Message)})
+	}
+}
+
+func deleteMessage(ctx context.Context, c *app.RequestContext) {
+	var req api.DeleteMessageRequest
+	err := c.Bind(&req)
+	if err != nil {
+		writeError(c, rpc.ErrorCode_INVALID_ARGUMENT, "Failed to parse request body: %v", err)
+		return
+	}
+	if !asCaller(c, &req.User) {
+		return
+	}
+	resp, err := cli.DeleteMessage(ctx, &rpc.DeleteMessageRequest{
+		Chat: req.Chat,
+		ID:   req.Id,
+		User: req.User,
+	})
+	if err != nil {
+		writeCallError(c, err)
+	} else if resp.Code != 0 {
+		writeRPCError(c, resp.Code, resp.Msg)
+	} else {
+		c.JSON(consts.StatusOK, &api.DeleteMessageResponse{})
+	}
+}
+
+func messageHistory(ctx context.Context, c *app.RequestContext) {
+	var req api.MessageHistoryRequest
+	err := c.Bind(&req)
+	if err != nil {
+		writeError(c, rpc.ErrorCode_INVALID_ARGUMENT, "Failed to parse request body: %v", err)
+		return
+	}
+	if !asCaller(c, &req.User) {
+		return
+	}
+	resp, err := cli.MessageHistory(ctx, &rpc.MessageHistoryRequest{
+		Chat: req.Chat,
+		ID:   req.Id,
+		User: req.User,
+	})
+	if err != nil {
+		writeCallError(c, err)
+		return
+	} else if resp.Code != 0 {
+		writeRPCError(c, resp.Code, resp.Msg)
+		return
+	}
+	messages := make([]*api.Message, 0, len(resp.Messages))
+	for _, msg := range resp.Messages {
+		messages = append(messages, newAPIMessage(msg))
+	}
+	c.JSON(consts.StatusOK, &api.MessageHistoryResponse{Messages: messages})
+}
@@ -0,0 +1,125 @@
+package main
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+
+	"github.com/TikTokTechImmersion/assignment_demo_2023/http-server/kitex_gen/rpc"
+	"github.com/TikTokTechImmersion/assignment_demo_2023/http-server/proto_gen/api"
+	"github.com/cloudwego/hertz/pkg/app"
+	"github.com/cloudwego/hertz/pkg/protocol/consts"
+)
+
+// requestIDKey is the key of the ID of a request in its context.
+const requestIDKey = "request_id"
+
+// maxRequestIDLen bounds the length of the request IDs set by clients.
+const maxRequestIDLen = 64
+
+// errorStatus maps the codes of the ErrorCode catalogue of the rpc-server to
+// the HTTP statuses of the failed requests.
+var errorStatus = map[rpc.ErrorCode]int{
+	rpc.ErrorCode_INVALID_ARGUMENT:  consts.StatusBadRequest,
+	rpc.ErrorCode_UNAUTHENTICATED:   consts.StatusUnauthorized,
+	rpc.ErrorCode_PERMISSION_DENIED: consts.StatusForbidden,
+	rpc.ErrorCode_NOT_FOUND:         consts.StatusNotFound,
+	rpc.ErrorCode_ALREADY_EXISTS:    consts.StatusConflict,
+	rpc.ErrorCode_DELETED:           consts.StatusGone,
+	rpc.ErrorCode_PAYLOAD_TOO_LARGE: consts.StatusRequestEntityTooLarge,
+	rpc.ErrorCode_RATE_LIMITED:      consts.StatusTooManyRequests,
+	rpc.ErrorCode_INTERNAL:          consts.StatusInternalServerError,
+	rpc.ErrorCode_UNAVAILABLE:       consts.StatusServiceUnavailable,
+}
+
+// requestID sets the ID of every request, taken from its X-Request-ID header
+// so that clients can correlate it with their own logs, or random. The ID is
+// sent back in the X-Request-ID header of the response, and in errors.
+func requestID(ctx context.Context, c *app.RequestContext) {
+	id := string(c.GetHeader("X-Request-ID"))
+	if !validRequestID(id) {
+		b := make([]byte, 16)
+		rand.Read(b)
+		id = hex.EncodeToString(b)
+	}
+	c.Set(requestIDKey, id)
+	c.Header("X-Request-ID", id)
+	c.Next(ctx)
+}
+
+// validRequestID reports whether id is a request ID clients can set: short,
+// and made of letters, digits, dashes, underscores and dots only, so that it
+// can be logged and echoed as is.
+func validRequestID(id string) bool {
+	if id == "" || len(id) > maxRequestIDLen {
+		return false
+	}
+	for _, r := range id {
+		if !(r >= 'a' && r <= 'z' || r >= 'A' && r <= 'Z' || r >= '0' && r <= '9' || r == '-' || r == '_' || r == '.') {
+			return false
+		}
+	}
+	return true
+}
+
+// errorCode returns code of a response of the rpc-server as an ErrorCode,
+// INTERNAL if it is not in the catalogue.
+func errorCode(code int32) rpc.ErrorCode {
+	if _, ok := errorStatus[rpc.ErrorCode(code)]; ok {
+		return rpc.ErrorCode(code)
+	}
+	return rpc.ErrorCode_INTERNAL
+}
+
+// httpStatus maps a non-zero response code of the rpc-server to the HTTP status
+// returned to clients.
+func httpStatus(code int32) int {
+	return errorStatus[errorCode(code)]
+}
+
+// retryable reports whether requests failing with code may succeed when
+// sent again unchanged.
+func retryable(code rpc.ErrorCode) bool {
+	return code == rpc.ErrorCode_RATE_LIMITED || code == rpc.ErrorCode_UNAVAILABLE
+}
+
+// newAPIError returns the api.Error of a request failing with code, the
+// message being formatted as by fmt.Sprintf if there are args.
+func newAPIError(c *app.RequestContext, code rpc.ErrorCode, format string, args ...interface{}) *api.Error {
+	msg := format
+	if len(args) > 0 {
+		msg = fmt.Sprintf(format, args...)
+	}
+	return &api.Error{
+		Code:      code.String(),
+		Message:   msg,
+		RequestId: c.GetString(requestIDKey),
+		Retryable: retryable(code),
+	}
+}
+
+// writeError rejects a request with code and an api.Error body, the message
+// being formatted as by fmt.Sprintf if there are args.
+func writeError(c *app.RequestContext, code rpc.ErrorCode, format string, args ...interface{}) {
+	render(c, errorStatus[code], newAPIError(c, code, format, args...))
+}
+
+// abortError rejects a request as writeError does, skipping the handlers
+// after the current middleware.
+func abortError(c *app.RequestContext, code rpc.ErrorCode, format string, args ...interface{}) {
+	c.Abort()
+	writeError(c, code, format, args...)
+}
+
+// writeRPCError rejects a request answered by the rpc-server with a non-zero
+// code and msg.
+func writeRPCError(c *app.RequestContext, code int32, msg string) {
+	writeError(c, errorCode(code), msg)
+}
+
+// writeCallError rejects a request whose call to the rpc-server failed. Such
+// failures are timeouts and connection errors, which may be transient.
+func writeCallError(c *app.RequestContext, err error) {
+	writeError(c, rpc.ErrorCode_UNAVAILABLE, err.Error())
+}
@@ -0,0 +1,143 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"strconv"
+	"testing"
+
+	"github.com/TikTokTechImmersion/assignment_demo_2023/http-server/kitex_gen/rpc"
+	"github.com/TikTokTechImmersion/assignment_demo_2023/http-server/proto_gen/api"
+	"github.com/cloudwego/hertz/pkg/app"
+	"github.com/cloudwego/hertz/pkg/common/ut"
+	"github.com/cloudwego/hertz/pkg/protocol/consts"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestHTTPStatus(t *testing.T) {
+	tests := []struct {
+		code int32
+		want int
+	}{
+		{code: int32(rpc.ErrorCode_INVALID_ARGUMENT), want: consts.StatusBadRequest},
+		{code: int32(rpc.ErrorCode_UNAUTHENTICATED), want: consts.StatusUnauthorized},
+		{code: int32(rpc.ErrorCode_PERMISSION_DENIED), want: consts.StatusForbidden},
+		{code: int32(rpc.ErrorCode_NOT_FOUND), want: consts.StatusNotFound},
+		{code: int32(rpc.ErrorCode_ALREADY_EXISTS), want: consts.StatusConflict},
+		{code: int32(rpc.ErrorCode_DELETED), want: consts.StatusGone},
+		{code: int32(rpc.ErrorCode_PAYLOAD_TOO_LARGE), want: consts.StatusRequestEntityTooLarge},
+		{code: int32(rpc.ErrorCode_RATE_LIMITED), want: consts.StatusTooManyRequests},
+		{code: int32(rpc.ErrorCode_INTERNAL), want: consts.StatusInternalServerError},
+		{code: int32(rpc.ErrorCode_UNAVAILABLE), want: consts.StatusServiceUnavailable},
+		// Codes missing from the catalogue are internal errors.
+		{code: 1, want: consts.StatusInternalServerError},
+		{code: 418, want: consts.StatusInternalServerError},
+	}
+	for _, tt := range tests {
+		assert.Equal(t, tt.want, httpStatus(tt.code), "code %d", tt.code)
+	}
+}
+
+func TestWriteRPCError(t *testing.T) {
+	e := newTestEngine(t)
+	e.GET("/rpc", func(ctx context.Context, c *app.RequestContext) {
+		code, _ := strconv.ParseInt(c.Query("code"), 10, 32)
+		writeRPCError(c, int32(code), "failed")
+	})
+	e.GET("/call", func(ctx context.Context, c *app.RequestContext) {
+		writeCallError(c, errors.New("rpc timeout"))
+	})
+	tests := []struct {
+		name          string
+		url           string
+		wantStatus    int
+		wantCode      string
+		wantMessage   string
+		wantRetryable bool
+	}{
+		{name: "not found", url: "/rpc?code=404", wantStatus: consts.StatusNotFound, wantCode: "NOT_FOUND", wantMessage: "failed"},
+		{name: "rate limited", url: "/rpc?code=429", wantStatus: consts.StatusTooManyRequests, wantCode: "RATE_LIMITED", wantMessage: "failed", wantRetryable: true},
+		{name: "unavailable", url: "/rpc?code=503", wantStatus: consts.StatusServiceUnavailable, wantCode: "UNAVAILABLE", wantMessage: "failed", wantRetryable: true},
+		{name: "unknown code", url: "/rpc?code=418", wantStatus: consts.StatusInternalServerError, wantCode: "INTERNAL", wantMessage: "failed"},
+		{name: "call error", url: "/call", wantStatus: consts.StatusServiceUnavailable, wantCode: "UNAVAILABLE", wantMessage: "rpc timeout", wantRetryable: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			w := performRequest(t, e, consts.MethodGet, tt.url, "doe", nil, ut.Header{Key: "X-Request-ID", Value: "req-1"})
+			assert.Equal(t, tt.wantStatus, w.Code)
+			assert.Equal(t, "req-1", string(w.Header().Peek("X-Request-ID")))
+			var body api.Error
+			assert.NoError(t, json.Unmarshal(w.Body.Bytes(), &body))
+			assert.Equal(t, tt.wantCode, body.Code)
+			assert.Equal(t, tt.wantMessage, body.Message)
+			assert.Equal(t, "req-1", body.RequestId)
+			assert.Equal(t, tt.wantRetryable, body.Retryable)
+		})
+	}
+
+	// Invalid request IDs are replaced by random ones.
+	w := performRequest(t, e, consts.MethodGet, "/call", "doe", nil, ut.Header{Key: "X-Request-ID", Value: "no spaces"})
+	id := string(w.Header().Peek("X-Request-ID"))
+	assert.Len(t, id, 32)
+	var body api.Error
+	assert.NoError(t, json.Unmarshal(w.Body.Bytes(), &body))
+	assert.Equal(t, id, body.RequestId)
+}
+
+func TestSendMessage_RetryAfter(t *testing.T) {
+	e := newTestEngine(t)
+	e.POST("/api/send", sendMessage)
+	retryAfterMs := int64(1500)
+	cli = &fakeIMService{send: func(req *rpc.SendRequest) *rpc.SendResponse {
+		return &rpc.SendResponse{Code: int32(rpc.ErrorCode_RATE_LIMITED), Msg: "too many messages", RetryAfterMs: &retryAfterMs}
+	}}
+	w := performRequest(t, e, consts.MethodPost, "/api/send", "doe", []byte(`{"chat":"doe:john","text":"hi"}`),
+		ut.Header{Key: "Content-Type", Value: "application/json"})
+	assert.Equal(t, consts.StatusTooManyRequests, w.Code)
+	// Waits are rounded up to whole seconds.
+	assert.Equal(t, "2", string(w.Header().Peek("Retry-After")))
+	var body api.Error
+	assert.NoError(t, json.Unmarshal(w.Body.Bytes(), &body))
+	assert.Equal(t, "RATE_LIMITED", body.Code)
+	assert.True(t, body.Retryable)
+}
+
+func TestBatchSend_Items(t *testing.T) {
+	e := newTestEngine(t)
+	e.POST("/api/batch_send", batchSend)
+	id, sendTime := int64(7), int64(100)
+	short, long := int64(1000), int64(2500)
+	cli = &fakeIMService{batchSend: func(req *rpc.BatchSendRequest) *rpc.BatchSendResponse {
+		return &rpc.BatchSendResponse{Responses: []*rpc.SendResponse{
+			{Code: 0, Msg: "success", ID: &id, SendTime: &sendTime},
+			{Code: int32(rpc.ErrorCode_PERMISSION_DENIED), Msg: "not a member"},
+			{Code: int32(rpc.ErrorCode_RATE_LIMITED), Msg: "too many messages", RetryAfterMs: &short},
+			{Code: int32(rpc.ErrorCode_RATE_LIMITED), Msg: "too many messages", RetryAfterMs: &long},
+			{Code: 418, Msg: "teapot"},
+		}}
+	}}
+	body := []byte(`{"requests":[{"chat":"doe:john","text":"1"},{"chat":"doe:jane","text":"2"},{"chat":"doe:john","text":"3"},{"chat":"doe:john","text":"4"},{"chat":"doe:john","text":"5"}]}`)
+	w := performRequest(t, e, consts.MethodPost, "/api/batch_send", "doe", body, ut.Header{Key: "Content-Type", Value: "application/json"})
+	assert.Equal(t, consts.StatusOK, w.Code)
+	// The batch can be sent again once the longest wait is over.
+	assert.Equal(t, "3", string(w.Header().Peek("Retry-After")))
+	var resp api.BatchSendResponse
+	assert.NoError(t, json.Unmarshal(w.Body.Bytes(), &resp))
+	want := []*api.SendResult{
+		{Status: consts.StatusOK, Id: 7, SendTime: 100},
+		{Status: consts.StatusForbidden, Error: "not a member", Code: "PERMISSION_DENIED"},
+		{Status: consts.StatusTooManyRequests, Error: "too many messages", Code: "RATE_LIMITED", Retryable: true},
+		{Status: consts.StatusTooManyRequests, Error: "too many messages", Code: "RATE_LIMITED", Retryable: true},
+		{Status: consts.StatusInternalServerError, Error: "teapot", Code: "INTERNAL"},
+	}
+	assert.Len(t, resp.Results, len(want))
+	for i, r := range resp.Results {
+		assert.Equal(t, want[i].Status, r.Status, "item %d", i)
+		assert.Equal(t, want[i].Error, r.Error, "item %d", i)
+		assert.Equal(t, want[i].Code, r.Code, "item %d", i)
+		assert.Equal(t, want[i].Retryable, r.Retryable, "item %d", i)
+		assert.Equal(t, want[i].Id, r.Id, "item %d", i)
+		assert.Equal(t, want[i].SendTime, r.SendTime, "item %d", i)
+	}
+}
@@ -0,0 +1,219 @@
+// Code generated by Kitex v0.5.2. DO NOT EDIT.
+
+package authservice
+
+import (
+	"context"
+	rpc "github.com/TikTokTechImmersion/assignment_demo_2023/http-server/kitex_gen/rpc"
+	client "github.com/cloudwego/kitex/client"
+	kitex "github.com/cloudwego/kitex/pkg/serviceinfo"
+)
+
+func serviceInfo() *kitex.ServiceInfo {
+	return authServiceServiceInfo
+}
+
+var authServiceServiceInfo = NewServiceInfo()
+
+func NewServiceInfo() *kitex.ServiceInfo {
+	serviceName := "AuthService"
+	handlerType := (*rpc.AuthService)(nil)
+	methods := map[string]kitex.MethodInfo{
+		"Register":        kitex.NewMethodInfo(registerHandler, newAuthServiceRegisterArgs, newAuthServiceRegisterResult, false),
+		"Login":           kitex.NewMethodInfo(loginHandler, newAuthServiceLoginArgs, newAuthServiceLoginResult, false),
+		"RefreshToken":    kitex.NewMethodInfo(refreshTokenHandler, newAuthServiceRefreshTokenArgs, newAuthServiceRefreshTokenResult, false),
+		"Logout":          kitex.NewMethodInfo(logoutHandler, newAuthServiceLogoutArgs, newAuthServiceLogoutResult, false),
+		"GetKeySet":       kitex.NewMethodInfo(getKeySetHandler, newAuthServiceGetKeySetArgs, newAuthServiceGetKeySetResult, false),
+		"RevokedSessions": kitex.NewMethodInfo(revokedSessionsHandler, newAuthServiceRevokedSessionsArgs, newAuthServiceRevokedSessionsResult, false),
+	}
+	extra := map[string]interface{}{
+		"PackageName": "rpc",
+	}
+	svcInfo := &kitex.ServiceInfo{
+		ServiceName:     serviceName,
+		HandlerType:     handlerType,
+		Methods:         methods,
+		PayloadCodec:    kitex.Thrift,
+		KiteXGenVersion: "v0.5.2",
+		Extra:           extra,
+	}
+	return svcInfo
+}
+
+func registerHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*rpc.AuthServiceRegisterArgs)
+	realResult := result.(*rpc.AuthServiceRegisterResult)
+	success, err := handler.(rpc.AuthService).Register(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newAuthServiceRegisterArgs() interface{} {
+	return rpc.NewAuthServiceRegisterArgs()
+}
+
+func newAuthServiceRegisterResult() interface{} {
+	return rpc.NewAuthServiceRegisterResult()
+}
+
+func loginHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*rpc.AuthServiceLoginArgs)
+	realResult := result.(*rpc.AuthServiceLoginResult)
+	success, err := handler.(rpc.AuthService).Login(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newAuthServiceLoginArgs() interface{} {
+	return rpc.NewAuthServiceLoginArgs()
+}
+
+func newAuthServiceLoginResult() interface{} {
+	return rpc.NewAuthServiceLoginResult()
+}
+
+func refreshTokenHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*rpc.AuthServiceRefreshTokenArgs)
+	realResult := result.(*rpc.AuthServiceRefreshTokenResult)
+	success, err := handler.(rpc.AuthService).RefreshToken(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newAuthServiceRefreshTokenArgs() interface{} {
+	return rpc.NewAuthServiceRefreshTokenArgs()
+}
+
+func newAuthServiceRefreshTokenResult() interface{} {
+	return rpc.NewAuthServiceRefreshTokenResult()
+}
+
+func logoutHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*rpc.AuthServiceLogoutArgs)
+	realResult := result.(*rpc.AuthServiceLogoutResult)
+	success, err := handler.(rpc.AuthService).Logout(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newAuthServiceLogoutArgs() interface{} {
+	return rpc.NewAuthServiceLogoutArgs()
+}
+
+func newAuthServiceLogoutResult() interface{} {
+	return rpc.NewAuthServiceLogoutResult()
+}
+
+func getKeySetHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*rpc.AuthServiceGetKeySetArgs)
+	realResult := result.(*rpc.AuthServiceGetKeySetResult)
+	success, err := handler.(rpc.AuthService).GetKeySet(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newAuthServiceGetKeySetArgs() interface{} {
+	return rpc.NewAuthServiceGetKeySetArgs()
+}
+
+func newAuthServiceGetKeySetResult() interface{} {
+	return rpc.NewAuthServiceGetKeySetResult()
+}
+
+func revokedSessionsHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*rpc.AuthServiceRevokedSessionsArgs)
+	realResult := result.(*rpc.AuthServiceRevokedSessionsResult)
+	success, err := handler.(rpc.AuthService).RevokedSessions(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newAuthServiceRevokedSessionsArgs() interface{} {
+	return rpc.NewAuthServiceRevokedSessionsArgs()
+}
+
+func newAuthServiceRevokedSessionsResult() interface{} {
+	return rpc.NewAuthServiceRevokedSessionsResult()
+}
+
+type kClient struct {
+	c client.Client
+}
+
+func newServiceClient(c client.Client) *kClient {
+	return &kClient{
+		c: c,
+	}
+}
+
+func (p *kClient) Register(ctx context.Context, req *rpc.RegisterRequest) (r *rpc.RegisterResponse, err error) {
+	var _args rpc.AuthServiceRegisterArgs
+	_args.Req = req
+	var _result rpc.AuthServiceRegisterResult
+	if err = p.c.Call(ctx, "Register", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) Login(ctx context.Context, req *rpc.LoginRequest) (r *rpc.LoginResponse, err error) {
+	var _args rpc.AuthServiceLoginArgs
+	_args.Req = req
+	var _result rpc.AuthServiceLoginResult
+	if err = p.c.Call(ctx, "Login", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) RefreshToken(ctx context.Context, req *rpc.RefreshTokenRequest) (r *rpc.RefreshTokenResponse, err error) {
+	var _args rpc.AuthServiceRefreshTokenArgs
+	_args.Req = req
+	var _result rpc.AuthServiceRefreshTokenResult
+	if err = p.c.Call(ctx, "RefreshToken", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) Logout(ctx context.Context, req *rpc.LogoutRequest) (r *rpc.LogoutResponse, err error) {
+	var _args rpc.AuthServiceLogoutArgs
+	_args.Req = req
+	var _result rpc.AuthServiceLogoutResult
+	if err = p.c.Call(ctx, "Logout", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) GetKeySet(ctx context.Context, req *rpc.GetKeySetRequest) (r *rpc.GetKeySetResponse, err error) {
+	var _args rpc.AuthServiceGetKeySetArgs
+	_args.Req = req
+	var _result rpc.AuthServiceGetKeySetResult
+	if err = p.c.Call(ctx, "GetKeySet", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) RevokedSessions(ctx context.Context, req *rpc.RevokedSessionsRequest) (r *rpc.RevokedSessionsResponse, err error) {
+	var _args rpc.AuthServiceRevokedSessionsArgs
+	_args.Req = req
+	var _result rpc.AuthServiceRevokedSessionsResult
+	if err = p.c.Call(ctx, "RevokedSessions", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
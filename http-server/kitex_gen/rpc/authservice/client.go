@@ -0,0 +1,79 @@
+// Code generated by Kitex v0.5.2. DO NOT EDIT.
+
+package authservice
+
+import (
+	"context"
+	rpc "github.com/TikTokTechImmersion/assignment_demo_2023/http-server/kitex_gen/rpc"
+	client "github.com/cloudwego/kitex/client"
+	callopt "github.com/cloudwego/kitex/client/callopt"
+)
+
+// Client is designed to provide IDL-compatible methods with call-option parameter for kitex framework.
+type Client interface {
+	Register(ctx context.Context, req *rpc.RegisterRequest, callOptions ...callopt.Option) (r *rpc.RegisterResponse, err error)
+	Login(ctx context.Context, req *rpc.LoginRequest, callOptions ...callopt.Option) (r *rpc.LoginResponse, err error)
+	RefreshToken(ctx context.Context, req *rpc.RefreshTokenRequest, callOptions ...callopt.Option) (r *rpc.RefreshTokenResponse, err error)
+	Logout(ctx context.Context, req *rpc.LogoutRequest, callOptions ...callopt.Option) (r *rpc.LogoutResponse, err error)
+	GetKeySet(ctx context.Context, req *rpc.GetKeySetRequest, callOptions ...callopt.Option) (r *rpc.GetKeySetResponse, err error)
+	RevokedSessions(ctx context.Context, req *rpc.RevokedSessionsRequest, callOptions ...callopt.Option) (r *rpc.RevokedSessionsResponse, err error)
+}
+
+// NewClient creates a client for the service defined in IDL.
+func NewClient(destService string, opts ...client.Option) (Client, error) {
+	var options []client.Option
+	options = append(options, client.WithDestService(destService))
+
+	options = append(options, opts...)
+
+	kc, err := client.NewClient(serviceInfo(), options...)
+	if err != nil {
+		return nil, err
+	}
+	return &kAuthServiceClient{
+		kClient: newServiceClient(kc),
+	}, nil
+}
+
+// MustNewClient creates a client for the service defined in IDL. It panics if any error occurs.
+func MustNewClient(destService string, opts ...client.Option) Client {
+	kc, err := NewClient(destService, opts...)
+	if err != nil {
+		panic(err)
+	}
+	return kc
+}
+
+type kAuthServiceClient struct {
+	*kClient
+}
+
+func (p *kAuthServiceClient) Register(ctx context.Context, req *rpc.RegisterRequest, callOptions ...callopt.Option) (r *rpc.RegisterResponse, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.Register(ctx, req)
+}
+
+func (p *kAuthServiceClient) Login(ctx context.Context, req *rpc.LoginRequest, callOptions ...callopt.Option) (r *rpc.LoginResponse, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.Login(ctx, req)
+}
+
+func (p *kAuthServiceClient) RefreshToken(ctx context.Context, req *rpc.RefreshTokenRequest, callOptions ...callopt.Option) (r *rpc.RefreshTokenResponse, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.RefreshToken(ctx, req)
+}
+
+func (p *kAuthServiceClient) Logout(ctx context.Context, req *rpc.LogoutRequest, callOptions ...callopt.Option) (r *rpc.LogoutResponse, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.Logout(ctx, req)
+}
+
+func (p *kAuthServiceClient) GetKeySet(ctx context.Context, req *rpc.GetKeySetRequest, callOptions ...callopt.Option) (r *rpc.GetKeySetResponse, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.GetKeySet(ctx, req)
+}
+
+func (p *kAuthServiceClient) RevokedSessions(ctx context.Context, req *rpc.RevokedSessionsRequest, callOptions ...callopt.Option) (r *rpc.RevokedSessionsResponse, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.RevokedSessions(ctx, req)
+}
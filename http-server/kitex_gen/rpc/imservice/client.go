@@ -13,6 +13,26 @@ import (
 type Client interface {
 	Send(ctx context.Context, req *rpc.SendRequest, callOptions ...callopt.Option) (r *rpc.SendResponse, err error)
 	Pull(ctx context.Context, req *rpc.PullRequest, callOptions ...callopt.Option) (r *rpc.PullResponse, err error)
+	CreateChat(ctx context.Context, req *rpc.CreateChatRequest, callOptions ...callopt.Option) (r *rpc.CreateChatResponse, err error)
+	AddMembers(ctx context.Context, req *rpc.AddMembersRequest, callOptions ...callopt.Option) (r *rpc.AddMembersResponse, err error)
+	RemoveMembers(ctx context.Context, req *rpc.RemoveMembersRequest, callOptions ...callopt.Option) (r *rpc.RemoveMembersResponse, err error)
+	ListMembers(ctx context.Context, req *rpc.ListMembersRequest, callOptions ...callopt.Option) (r *rpc.ListMembersResponse, err error)
+	Subscribe(ctx context.Context, req *rpc.SubscribeRequest, callOptions ...callopt.Option) (r *rpc.SubscribeResponse, err error)
+	BatchSend(ctx context.Context, req *rpc.BatchSendRequest, callOptions ...callopt.Option) (r *rpc.BatchSendResponse, err error)
+	MultiPull(ctx context.Context, req *rpc.MultiPullRequest, callOptions ...callopt.Option) (r *rpc.MultiPullResponse, err error)
+	ListChats(ctx context.Context, req *rpc.ListChatsRequest, callOptions ...callopt.Option) (r *rpc.ListChatsResponse, err error)
+	MarkRead(ctx context.Context, req *rpc.MarkReadRequest, callOptions ...callopt.Option) (r *rpc.MarkReadResponse, err error)
+	EditMessage(ctx context.Context, req *rpc.EditMessageRequest, callOptions ...callopt.Option) (r *rpc.EditMessageResponse, err error)
+	DeleteMessage(ctx context.Context, req *rpc.DeleteMessageRequest, callOptions ...callopt.Option) (r *rpc.DeleteMessageResponse, err error)
+	MessageHistory(ctx context.Context, req *rpc.MessageHistoryRequest, callOptions ...callopt.Option) (r *rpc.MessageHistoryResponse, err error)
+	PullThread(ctx context.Context, req *rpc.PullThreadRequest, callOptions ...callopt.Option) (r *rpc.PullThreadResponse, err error)
+	AddReaction(ctx context.Context, req *rpc.AddReactionRequest, callOptions ...callopt.Option) (r *rpc.AddReactionResponse, err error)
+	RemoveReaction(ctx context.Context, req *rpc.RemoveReactionRequest, callOptions ...callopt.Option) (r *rpc.RemoveReactionResponse, err error)
+	Search(ctx context.Context, req *rpc.SearchRequest, callOptions ...callopt.Option) (r *rpc.SearchResponse, err error)
+	GetRetention(ctx context.Context, req *rpc.GetRetentionRequest, callOptions ...callopt.Option) (r *rpc.GetRetentionResponse, err error)
+	SetRetention(ctx context.Context, req *rpc.SetRetentionRequest, callOptions ...callopt.Option) (r *rpc.SetRetentionResponse, err error)
+	ReleasedBlobs(ctx context.Context, req *rpc.ReleasedBlobsRequest, callOptions ...callopt.Option) (r *rpc.ReleasedBlobsResponse, err error)
+	RegisterBlob(ctx context.Context, req *rpc.RegisterBlobRequest, callOptions ...callopt.Option) (r *rpc.RegisterBlobResponse, err error)
 }
 
 // NewClient creates a client for the service defined in IDL.
@@ -53,3 +73,103 @@ func (p *kIMServiceClient) Pull(ctx context.Context, req *rpc.PullRequest, callO
 	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
 	return p.kClient.Pull(ctx, req)
 }
+
+func (p *kIMServiceClient) CreateChat(ctx context.Context, req *rpc.CreateChatRequest, callOptions ...callopt.Option) (r *rpc.CreateChatResponse, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.CreateChat(ctx, req)
+}
+
+func (p *kIMServiceClient) AddMembers(ctx context.Context, req *rpc.AddMembersRequest, callOptions ...callopt.Option) (r *rpc.AddMembersResponse, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.AddMembers(ctx, req)
+}
+
+func (p *kIMServiceClient) RemoveMembers(ctx context.Context, req *rpc.RemoveMembersRequest, callOptions ...callopt.Option) (r *rpc.RemoveMembersResponse, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.RemoveMembers(ctx, req)
+}
+
+func (p *kIMServiceClient) ListMembers(ctx context.Context, req *rpc.ListMembersRequest, callOptions ...callopt.Option) (r *rpc.ListMembersResponse, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.ListMembers(ctx, req)
+}
+
+func (p *kIMServiceClient) Subscribe(ctx context.Context, req *rpc.SubscribeRequest, callOptions ...callopt.Option) (r *rpc.SubscribeResponse, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.Subscribe(ctx, req)
+}
+
+func (p *kIMServiceClient) BatchSend(ctx context.Context, req *rpc.BatchSendRequest, callOptions ...callopt.Option) (r *rpc.BatchSendResponse, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.BatchSend(ctx, req)
+}
+
+func (p *kIMServiceClient) MultiPull(ctx context.Context, req *rpc.MultiPullRequest, callOptions ...callopt.Option) (r *rpc.MultiPullResponse, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.MultiPull(ctx, req)
+}
+
+func (p *kIMServiceClient) ListChats(ctx context.Context, req *rpc.ListChatsRequest, callOptions ...callopt.Option) (r *rpc.ListChatsResponse, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.ListChats(ctx, req)
+}
+
+func (p *kIMServiceClient) MarkRead(ctx context.Context, req *rpc.MarkReadRequest, callOptions ...callopt.Option) (r *rpc.MarkReadResponse, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.MarkRead(ctx, req)
+}
+
+func (p *kIMServiceClient) EditMessage(ctx context.Context, req *rpc.EditMessageRequest, callOptions ...callopt.Option) (r *rpc.EditMessageResponse, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.EditMessage(ctx, req)
+}
+
+func (p *kIMServiceClient) DeleteMessage(ctx context.Context, req *rpc.DeleteMessageRequest, callOptions ...callopt.Option) (r *rpc.DeleteMessageResponse, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.DeleteMessage(ctx, req)
+}
+
+func (p *kIMServiceClient) MessageHistory(ctx context.Context, req *rpc.MessageHistoryRequest, callOptions ...callopt.Option) (r *rpc.MessageHistoryResponse, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.MessageHistory(ctx, req)
+}
+
+func (p *kIMServiceClient) PullThread(ctx context.Context, req *rpc.PullThreadRequest, callOptions ...callopt.Option) (r *rpc.PullThreadResponse, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.PullThread(ctx, req)
+}
+
+func (p *kIMServiceClient) AddReaction(ctx context.Context, req *rpc.AddReactionRequest, callOptions ...callopt.Option) (r *rpc.AddReactionResponse, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.AddReaction(ctx, req)
+}
+
+func (p *kIMServiceClient) RemoveReaction(ctx context.Context, req *rpc.RemoveReactionRequest, callOptions ...callopt.Option) (r *rpc.RemoveReactionResponse, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.RemoveReaction(ctx, req)
+}
+
+func (p *kIMServiceClient) Search(ctx context.Context, req *rpc.SearchRequest, callOptions ...callopt.Option) (r *rpc.SearchResponse, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.Search(ctx, req)
+}
+
+func (p *kIMServiceClient) GetRetention(ctx context.Context, req *rpc.GetRetentionRequest, callOptions ...callopt.Option) (r *rpc.GetRetentionResponse, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.GetRetention(ctx, req)
+}
+
+func (p *kIMServiceClient) SetRetention(ctx context.Context, req *rpc.SetRetentionRequest, callOptions ...callopt.Option) (r *rpc.SetRetentionResponse, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.SetRetention(ctx, req)
+}
+
+func (p *kIMServiceClient) ReleasedBlobs(ctx context.Context, req *rpc.ReleasedBlobsRequest, callOptions ...callopt.Option) (r *rpc.ReleasedBlobsResponse, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.ReleasedBlobs(ctx, req)
+}
+
+func (p *kIMServiceClient) RegisterBlob(ctx context.Context, req *rpc.RegisterBlobRequest, callOptions ...callopt.Option) (r *rpc.RegisterBlobResponse, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.RegisterBlob(ctx, req)
+}
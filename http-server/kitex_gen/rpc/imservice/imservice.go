@@ -19,8 +19,28 @@ func NewServiceInfo() *kitex.ServiceInfo {
 	serviceName := "IMService"
 	handlerType := (*rpc.IMService)(nil)
 	methods := map[string]kitex.MethodInfo{
-		"Send": kitex.NewMethodInfo(sendHandler, newIMServiceSendArgs, newIMServiceSendResult, false),
-		"Pull": kitex.NewMethodInfo(pullHandler, newIMServicePullArgs, newIMServicePullResult, false),
+		"Send":           kitex.NewMethodInfo(sendHandler, newIMServiceSendArgs, newIMServiceSendResult, false),
+		"Pull":           kitex.NewMethodInfo(pullHandler, newIMServicePullArgs, newIMServicePullResult, false),
+		"CreateChat":     kitex.NewMethodInfo(createChatHandler, newIMServiceCreateChatArgs, newIMServiceCreateChatResult, false),
+		"AddMembers":     kitex.NewMethodInfo(addMembersHandler, newIMServiceAddMembersArgs, newIMServiceAddMembersResult, false),
+		"RemoveMembers":  kitex.NewMethodInfo(removeMembersHandler, newIMServiceRemoveMembersArgs, newIMServiceRemoveMembersResult, false),
+		"ListMembers":    kitex.NewMethodInfo(listMembersHandler, newIMServiceListMembersArgs, newIMServiceListMembersResult, false),
+		"Subscribe":      kitex.NewMethodInfo(subscribeHandler, newIMServiceSubscribeArgs, newIMServiceSubscribeResult, false),
+		"BatchSend":      kitex.NewMethodInfo(batchSendHandler, newIMServiceBatchSendArgs, newIMServiceBatchSendResult, false),
+		"MultiPull":      kitex.NewMethodInfo(multiPullHandler, newIMServiceMultiPullArgs, newIMServiceMultiPullResult, false),
+		"ListChats":      kitex.NewMethodInfo(listChatsHandler, newIMServiceListChatsArgs, newIMServiceListChatsResult, false),
+		"MarkRead":       kitex.NewMethodInfo(markReadHandler, newIMServiceMarkReadArgs, newIMServiceMarkReadResult, false),
+		"EditMessage":    kitex.NewMethodInfo(editMessageHandler, newIMServiceEditMessageArgs, newIMServiceEditMessageResult, false),
+		"DeleteMessage":  kitex.NewMethodInfo(deleteMessageHandler, newIMServiceDeleteMessageArgs, newIMServiceDeleteMessageResult, false),
+		"MessageHistory": kitex.NewMethodInfo(messageHistoryHandler, newIMServiceMessageHistoryArgs, newIMServiceMessageHistoryResult, false),
+		"PullThread":     kitex.NewMethodInfo(pullThreadHandler, newIMServicePullThreadArgs, newIMServicePullThreadResult, false),
+		"AddReaction":    kitex.NewMethodInfo(addReactionHandler, newIMServiceAddReactionArgs, newIMServiceAddReactionResult, false),
+		"RemoveReaction": kitex.NewMethodInfo(removeReactionHandler, newIMServiceRemoveReactionArgs, newIMServiceRemoveReactionResult, false),
+		"Search":         kitex.NewMethodInfo(searchHandler, newIMServiceSearchArgs, newIMServiceSearchResult, false),
+		"GetRetention":   kitex.NewMethodInfo(getRetentionHandler, newIMServiceGetRetentionArgs, newIMServiceGetRetentionResult, false),
+		"SetRetention":   kitex.NewMethodInfo(setRetentionHandler, newIMServiceSetRetentionArgs, newIMServiceSetRetentionResult, false),
+		"ReleasedBlobs":  kitex.NewMethodInfo(releasedBlobsHandler, newIMServiceReleasedBlobsArgs, newIMServiceReleasedBlobsResult, false),
+		"RegisterBlob":   kitex.NewMethodInfo(registerBlobHandler, newIMServiceRegisterBlobArgs, newIMServiceRegisterBlobResult, false),
 	}
 	extra := map[string]interface{}{
 		"PackageName": "rpc",
@@ -72,6 +92,366 @@ func newIMServicePullResult() interface{} {
 	return rpc.NewIMServicePullResult()
 }
 
+func createChatHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*rpc.IMServiceCreateChatArgs)
+	realResult := result.(*rpc.IMServiceCreateChatResult)
+	success, err := handler.(rpc.IMService).CreateChat(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newIMServiceCreateChatArgs() interface{} {
+	return rpc.NewIMServiceCreateChatArgs()
+}
+
+func newIMServiceCreateChatResult() interface{} {
+	return rpc.NewIMServiceCreateChatResult()
+}
+
+func addMembersHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*rpc.IMServiceAddMembersArgs)
+	realResult := result.(*rpc.IMServiceAddMembersResult)
+	success, err := handler.(rpc.IMService).AddMembers(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newIMServiceAddMembersArgs() interface{} {
+	return rpc.NewIMServiceAddMembersArgs()
+}
+
+func newIMServiceAddMembersResult() interface{} {
+	return rpc.NewIMServiceAddMembersResult()
+}
+
+func removeMembersHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*rpc.IMServiceRemoveMembersArgs)
+	realResult := result.(*rpc.IMServiceRemoveMembersResult)
+	success, err := handler.(rpc.IMService).RemoveMembers(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newIMServiceRemoveMembersArgs() interface{} {
+	return rpc.NewIMServiceRemoveMembersArgs()
+}
+
+func newIMServiceRemoveMembersResult() interface{} {
+	return rpc.NewIMServiceRemoveMembersResult()
+}
+
+func listMembersHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*rpc.IMServiceListMembersArgs)
+	realResult := result.(*rpc.IMServiceListMembersResult)
+	success, err := handler.(rpc.IMService).ListMembers(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newIMServiceListMembersArgs() interface{} {
+	return rpc.NewIMServiceListMembersArgs()
+}
+
+func newIMServiceListMembersResult() interface{} {
+	return rpc.NewIMServiceListMembersResult()
+}
+
+func subscribeHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*rpc.IMServiceSubscribeArgs)
+	realResult := result.(*rpc.IMServiceSubscribeResult)
+	success, err := handler.(rpc.IMService).Subscribe(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newIMServiceSubscribeArgs() interface{} {
+	return rpc.NewIMServiceSubscribeArgs()
+}
+
+func newIMServiceSubscribeResult() interface{} {
+	return rpc.NewIMServiceSubscribeResult()
+}
+
+func batchSendHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*rpc.IMServiceBatchSendArgs)
+	realResult := result.(*rpc.IMServiceBatchSendResult)
+	success, err := handler.(rpc.IMService).BatchSend(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newIMServiceBatchSendArgs() interface{} {
+	return rpc.NewIMServiceBatchSendArgs()
+}
+
+func newIMServiceBatchSendResult() interface{} {
+	return rpc.NewIMServiceBatchSendResult()
+}
+
+func multiPullHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*rpc.IMServiceMultiPullArgs)
+	realResult := result.(*rpc.IMServiceMultiPullResult)
+	success, err := handler.(rpc.IMService).MultiPull(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newIMServiceMultiPullArgs() interface{} {
+	return rpc.NewIMServiceMultiPullArgs()
+}
+
+func newIMServiceMultiPullResult() interface{} {
+	return rpc.NewIMServiceMultiPullResult()
+}
+
+func listChatsHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*rpc.IMServiceListChatsArgs)
+	realResult := result.(*rpc.IMServiceListChatsResult)
+	success, err := handler.(rpc.IMService).ListChats(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newIMServiceListChatsArgs() interface{} {
+	return rpc.NewIMServiceListChatsArgs()
+}
+
+func newIMServiceListChatsResult() interface{} {
+	return rpc.NewIMServiceListChatsResult()
+}
+
+func markReadHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*rpc.IMServiceMarkReadArgs)
+	realResult := result.(*rpc.IMServiceMarkReadResult)
+	success, err := handler.(rpc.IMService).MarkRead(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newIMServiceMarkReadArgs() interface{} {
+	return rpc.NewIMServiceMarkReadArgs()
+}
+
+func newIMServiceMarkReadResult() interface{} {
+	return rpc.NewIMServiceMarkReadResult()
+}
+
+func editMessageHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*rpc.IMServiceEditMessageArgs)
+	realResult := result.(*rpc.IMServiceEditMessageResult)
+	success, err := handler.(rpc.IMService).EditMessage(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newIMServiceEditMessageArgs() interface{} {
+	return rpc.NewIMServiceEditMessageArgs()
+}
+
+func newIMServiceEditMessageResult() interface{} {
+	return rpc.NewIMServiceEditMessageResult()
+}
+
+func deleteMessageHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*rpc.IMServiceDeleteMessageArgs)
+	realResult := result.(*rpc.IMServiceDeleteMessageResult)
+	success, err := handler.(rpc.IMService).DeleteMessage(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newIMServiceDeleteMessageArgs() interface{} {
+	return rpc.NewIMServiceDeleteMessageArgs()
+}
+
+func newIMServiceDeleteMessageResult() interface{} {
+	return rpc.NewIMServiceDeleteMessageResult()
+}
+
+func messageHistoryHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*rpc.IMServiceMessageHistoryArgs)
+	realResult := result.(*rpc.IMServiceMessageHistoryResult)
+	success, err := handler.(rpc.IMService).MessageHistory(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newIMServiceMessageHistoryArgs() interface{} {
+	return rpc.NewIMServiceMessageHistoryArgs()
+}
+
+func newIMServiceMessageHistoryResult() interface{} {
+	return rpc.NewIMServiceMessageHistoryResult()
+}
+
+func pullThreadHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*rpc.IMServicePullThreadArgs)
+	realResult := result.(*rpc.IMServicePullThreadResult)
+	success, err := handler.(rpc.IMService).PullThread(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newIMServicePullThreadArgs() interface{} {
+	return rpc.NewIMServicePullThreadArgs()
+}
+
+func newIMServicePullThreadResult() interface{} {
+	return rpc.NewIMServicePullThreadResult()
+}
+
+func addReactionHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*rpc.IMServiceAddReactionArgs)
+	realResult := result.(*rpc.IMServiceAddReactionResult)
+	success, err := handler.(rpc.IMService).AddReaction(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newIMServiceAddReactionArgs() interface{} {
+	return rpc.NewIMServiceAddReactionArgs()
+}
+
+func newIMServiceAddReactionResult() interface{} {
+	return rpc.NewIMServiceAddReactionResult()
+}
+
+func removeReactionHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*rpc.IMServiceRemoveReactionArgs)
+	realResult := result.(*rpc.IMServiceRemoveReactionResult)
+	success, err := handler.(rpc.IMService).RemoveReaction(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newIMServiceRemoveReactionArgs() interface{} {
+	return rpc.NewIMServiceRemoveReactionArgs()
+}
+
+func newIMServiceRemoveReactionResult() interface{} {
+	return rpc.NewIMServiceRemoveReactionResult()
+}
+
+func searchHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*rpc.IMServiceSearchArgs)
+	realResult := result.(*rpc.IMServiceSearchResult)
+	success, err := handler.(rpc.IMService).Search(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newIMServiceSearchArgs() interface{} {
+	return rpc.NewIMServiceSearchArgs()
+}
+
+func newIMServiceSearchResult() interface{} {
+	return rpc.NewIMServiceSearchResult()
+}
+
+func getRetentionHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*rpc.IMServiceGetRetentionArgs)
+	realResult := result.(*rpc.IMServiceGetRetentionResult)
+	success, err := handler.(rpc.IMService).GetRetention(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newIMServiceGetRetentionArgs() interface{} {
+	return rpc.NewIMServiceGetRetentionArgs()
+}
+
+func newIMServiceGetRetentionResult() interface{} {
+	return rpc.NewIMServiceGetRetentionResult()
+}
+
+func setRetentionHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*rpc.IMServiceSetRetentionArgs)
+	realResult := result.(*rpc.IMServiceSetRetentionResult)
+	success, err := handler.(rpc.IMService).SetRetention(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newIMServiceSetRetentionArgs() interface{} {
+	return rpc.NewIMServiceSetRetentionArgs()
+}
+
+func newIMServiceSetRetentionResult() interface{} {
+	return rpc.NewIMServiceSetRetentionResult()
+}
+
+func releasedBlobsHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*rpc.IMServiceReleasedBlobsArgs)
+	realResult := result.(*rpc.IMServiceReleasedBlobsResult)
+	success, err := handler.(rpc.IMService).ReleasedBlobs(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newIMServiceReleasedBlobsArgs() interface{} {
+	return rpc.NewIMServiceReleasedBlobsArgs()
+}
+
+func newIMServiceReleasedBlobsResult() interface{} {
+	return rpc.NewIMServiceReleasedBlobsResult()
+}
+
+func registerBlobHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*rpc.IMServiceRegisterBlobArgs)
+	realResult := result.(*rpc.IMServiceRegisterBlobResult)
+	success, err := handler.(rpc.IMService).RegisterBlob(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newIMServiceRegisterBlobArgs() interface{} {
+	return rpc.NewIMServiceRegisterBlobArgs()
+}
+
+func newIMServiceRegisterBlobResult() interface{} {
+	return rpc.NewIMServiceRegisterBlobResult()
+}
+
 type kClient struct {
 	c client.Client
 }
@@ -101,3 +481,203 @@ func (p *kClient) Pull(ctx context.Context, req *rpc.PullRequest) (r *rpc.PullRe
 	}
 	return _result.GetSuccess(), nil
 }
+
+func (p *kClient) CreateChat(ctx context.Context, req *rpc.CreateChatRequest) (r *rpc.CreateChatResponse, err error) {
+	var _args rpc.IMServiceCreateChatArgs
+	_args.Req = req
+	var _result rpc.IMServiceCreateChatResult
+	if err = p.c.Call(ctx, "CreateChat", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) AddMembers(ctx context.Context, req *rpc.AddMembersRequest) (r *rpc.AddMembersResponse, err error) {
+	var _args rpc.IMServiceAddMembersArgs
+	_args.Req = req
+	var _result rpc.IMServiceAddMembersResult
+	if err = p.c.Call(ctx, "AddMembers", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) RemoveMembers(ctx context.Context, req *rpc.RemoveMembersRequest) (r *rpc.RemoveMembersResponse, err error) {
+	var _args rpc.IMServiceRemoveMembersArgs
+	_args.Req = req
+	var _result rpc.IMServiceRemoveMembersResult
+	if err = p.c.Call(ctx, "RemoveMembers", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) ListMembers(ctx context.Context, req *rpc.ListMembersRequest) (r *rpc.ListMembersResponse, err error) {
+	var _args rpc.IMServiceListMembersArgs
+	_args.Req = req
+	var _result rpc.IMServiceListMembersResult
+	if err = p.c.Call(ctx, "ListMembers", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) Subscribe(ctx context.Context, req *rpc.SubscribeRequest) (r *rpc.SubscribeResponse, err error) {
+	var _args rpc.IMServiceSubscribeArgs
+	_args.Req = req
+	var _result rpc.IMServiceSubscribeResult
+	if err = p.c.Call(ctx, "Subscribe", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) BatchSend(ctx context.Context, req *rpc.BatchSendRequest) (r *rpc.BatchSendResponse, err error) {
+	var _args rpc.IMServiceBatchSendArgs
+	_args.Req = req
+	var _result rpc.IMServiceBatchSendResult
+	if err = p.c.Call(ctx, "BatchSend", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) MultiPull(ctx context.Context, req *rpc.MultiPullRequest) (r *rpc.MultiPullResponse, err error) {
+	var _args rpc.IMServiceMultiPullArgs
+	_args.Req = req
+	var _result rpc.IMServiceMultiPullResult
+	if err = p.c.Call(ctx, "MultiPull", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) ListChats(ctx context.Context, req *rpc.ListChatsRequest) (r *rpc.ListChatsResponse, err error) {
+	var _args rpc.IMServiceListChatsArgs
+	_args.Req = req
+	var _result rpc.IMServiceListChatsResult
+	if err = p.c.Call(ctx, "ListChats", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) MarkRead(ctx context.Context, req *rpc.MarkReadRequest) (r *rpc.MarkReadResponse, err error) {
+	var _args rpc.IMServiceMarkReadArgs
+	_args.Req = req
+	var _result rpc.IMServiceMarkReadResult
+	if err = p.c.Call(ctx, "MarkRead", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) EditMessage(ctx context.Context, req *rpc.EditMessageRequest) (r *rpc.EditMessageResponse, err error) {
+	var _args rpc.IMServiceEditMessageArgs
+	_args.Req = req
+	var _result rpc.IMServiceEditMessageResult
+	if err = p.c.Call(ctx, "EditMessage", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) DeleteMessage(ctx context.Context, req *rpc.DeleteMessageRequest) (r *rpc.DeleteMessageResponse, err error) {
+	var _args rpc.IMServiceDeleteMessageArgs
+	_args.Req = req
+	var _result rpc.IMServiceDeleteMessageResult
+	if err = p.c.Call(ctx, "DeleteMessage", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) MessageHistory(ctx context.Context, req *rpc.MessageHistoryRequest) (r *rpc.MessageHistoryResponse, err error) {
+	var _args rpc.IMServiceMessageHistoryArgs
+	_args.Req = req
+	var _result rpc.IMServiceMessageHistoryResult
+	if err = p.c.Call(ctx, "MessageHistory", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) PullThread(ctx context.Context, req *rpc.PullThreadRequest) (r *rpc.PullThreadResponse, err error) {
+	var _args rpc.IMServicePullThreadArgs
+	_args.Req = req
+	var _result rpc.IMServicePullThreadResult
+	if err = p.c.Call(ctx, "PullThread", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) AddReaction(ctx context.Context, req *rpc.AddReactionRequest) (r *rpc.AddReactionResponse, err error) {
+	var _args rpc.IMServiceAddReactionArgs
+	_args.Req = req
+	var _result rpc.IMServiceAddReactionResult
+	if err = p.c.Call(ctx, "AddReaction", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) RemoveReaction(ctx context.Context, req *rpc.RemoveReactionRequest) (r *rpc.RemoveReactionResponse, err error) {
+	var _args rpc.IMServiceRemoveReactionArgs
+	_args.Req = req
+	var _result rpc.IMServiceRemoveReactionResult
+	if err = p.c.Call(ctx, "RemoveReaction", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) Search(ctx context.Context, req *rpc.SearchRequest) (r *rpc.SearchResponse, err error) {
+	var _args rpc.IMServiceSearchArgs
+	_args.Req = req
+	var _result rpc.IMServiceSearchResult
+	if err = p.c.Call(ctx, "Search", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) GetRetention(ctx context.Context, req *rpc.GetRetentionRequest) (r *rpc.GetRetentionResponse, err error) {
+	var _args rpc.IMServiceGetRetentionArgs
+	_args.Req = req
+	var _result rpc.IMServiceGetRetentionResult
+	if err = p.c.Call(ctx, "GetRetention", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) SetRetention(ctx context.Context, req *rpc.SetRetentionRequest) (r *rpc.SetRetentionResponse, err error) {
+	var _args rpc.IMServiceSetRetentionArgs
+	_args.Req = req
+	var _result rpc.IMServiceSetRetentionResult
+	if err = p.c.Call(ctx, "SetRetention", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) ReleasedBlobs(ctx context.Context, req *rpc.ReleasedBlobsRequest) (r *rpc.ReleasedBlobsResponse, err error) {
+	var _args rpc.IMServiceReleasedBlobsArgs
+	_args.Req = req
+	var _result rpc.IMServiceReleasedBlobsResult
+	if err = p.c.Call(ctx, "ReleasedBlobs", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) RegisterBlob(ctx context.Context, req *rpc.RegisterBlobRequest) (r *rpc.RegisterBlobResponse, err error) {
+	var _args rpc.IMServiceRegisterBlobArgs
+	_args.Req = req
+	var _result rpc.IMServiceRegisterBlobResult
+	if err = p.c.Call(ctx, "RegisterBlob", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
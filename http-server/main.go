@@ -3,9 +3,11 @@ package main
 import (
 	"context"
 	"log"
+	"os"
 	"time"
 
 	"github.com/TikTokTechImmersion/assignment_demo_2023/http-server/kitex_gen/rpc"
+	"github.com/TikTokTechImmersion/assignment_demo_2023/http-server/kitex_gen/rpc/authservice"
 	"github.com/TikTokTechImmersion/assignment_demo_2023/http-server/kitex_gen/rpc/imservice"
 	"github.com/TikTokTechImmersion/assignment_demo_2023/http-server/proto_gen/api"
 	"github.com/cloudwego/hertz/pkg/app"
@@ -13,10 +15,17 @@ import (
 	"github.com/cloudwego/hertz/pkg/common/utils"
 	"github.com/cloudwego/hertz/pkg/protocol/consts"
 	"github.com/cloudwego/kitex/client"
+	"github.com/cloudwego/kitex/client/callopt"
 	etcd "github.com/kitex-contrib/registry-etcd"
 )
 
-var cli imservice.Client
+var (
+	cli     imservice.Client
+	authCli authservice.Client
+)
+
+// rpcTimeout is the default timeout of the calls to the rpc-server.
+const rpcTimeout = 1 * time.Second
 
 func main() {
 	r, err := etcd.NewEtcdResolver([]string{"etcd:2379"})
@@ -25,78 +34,354 @@ func main() {
 	}
 	cli = imservice.MustNewClient("demo.rpc.server",
 		client.WithResolver(r),
-		client.WithRPCTimeout(1*time.Second),
+		client.WithRPCTimeout(rpcTimeout),
 		client.WithHostPorts("rpc-server:8888"),
 	)
+	authCli = authservice.MustNewClient("demo.auth.server",
+		client.WithResolver(r),
+		client.WithRPCTimeout(rpcTimeout),
+		client.WithHostPorts("rpc-server:8889"),
+	)
+
+	blobs, err = NewLocalBlobStore(getenv("BLOB_DIR", "data/blobs"))
+	if err != nil {
+		log.Fatal(err)
+	}
+	signer, err = newBlobSigner(os.Getenv("BLOB_SIGNING_KEY"))
+	if err != nil {
+		log.Fatal(err)
+	}
+	// Every http-server deleting blobs keeps its own cursor in the released
+	// blobs of the rpc-server.
+	consumer := os.Getenv("BLOB_CONSUMER")
+	if consumer == "" {
+		consumer, err = os.Hostname()
+		if err != nil {
+			log.Fatal(err)
+		}
+	}
+	go reapBlobs(context.Background(), consumer, blobReapInterval)
+	// Tokens are issued by the AuthService of the rpc-server, unless they are
+	// issued elsewhere and verified with a key set of their own.
+	if path := os.Getenv("JWT_KEYS_FILE"); path != "" {
+		auth, err = loadAuthenticator(path, os.Getenv("JWT_ISSUER"), os.Getenv("JWT_AUDIENCE"))
+		if err != nil {
+			log.Fatal(err)
+		}
+	} else {
+		auth = newRemoteAuthenticator(fetchKeySet, os.Getenv("JWT_ISSUER"), os.Getenv("JWT_AUDIENCE"))
+		go auth.watchRevocations(context.Background(), fetchRevokedSessions, revocationRefreshInterval)
+	}
+
+	limits, err = loadRateLimits()
+	if err != nil {
+		log.Fatal(err)
+	}
 
-	h := server.Default(server.WithHostPorts("0.0.0.0:8080"))
+	// Request bodies are streamed so that uploads are not held in memory,
+	// see limitBody.
+	h := server.Default(server.WithHostPorts("0.0.0.0:8080"), server.WithStreamBody(true))
+	h.Use(requestID, authenticate, limitBody)
 
 	h.GET("/ping", func(c context.Context, ctx *app.RequestContext) {
 		ctx.JSON(consts.StatusOK, utils.H{"message": "pong"})
 	})
 
-	h.POST("/api/send", sendMessage)
-	h.GET("/api/pull", pullMessage)
+	h.POST("/api/send", negotiate, sendMessage)
+	h.GET("/api/pull", negotiate, pullMessage)
+	h.POST("/api/chat/create", createChat)
+	h.POST("/api/chat/add_members", addMembers)
+	h.POST("/api/chat/remove_members", removeMembers)
+	h.GET("/api/chat/members", listMembers)
+	h.GET("/api/ws", subscribeWS)
+	h.GET("/api/stream", streamMessages)
+	h.POST("/api/batch_send", batchSend)
+	h.GET("/api/multi_pull", multiPull)
+	h.GET("/api/chats", listChats)
+	h.POST("/api/read", markRead)
+	h.POST("/api/message/edit", editMessage)
+	h.POST("/api/message/delete", deleteMessage)
+	h.GET("/api/message/history", messageHistory)
+	h.GET("/api/thread", pullThread)
+	h.POST("/api/reaction/add", addReaction)
+	h.POST("/api/reaction/remove", removeReaction)
+	h.GET("/api/search", search)
+	h.POST("/api/upload", upload)
+	h.GET("/api/blob/:key", downloadBlob)
+	h.GET("/api/chat/retention", getRetention)
+	h.POST("/api/auth/register", register)
+	h.POST("/api/auth/login", login)
+	h.POST("/api/auth/refresh", refreshToken)
+	h.POST("/api/auth/logout", logout)
+	h.POST("/api/chat/retention", setRetention)
 
 	h.Spin()
 }
 
 func sendMessage(ctx context.Context, c *app.RequestContext) {
 	var req api.SendRequest
-	err := c.Bind(&req)
+	err := bind(c, &req)
 	if err != nil {
-		c.String(consts.StatusBadRequest, "Failed to parse request body: %v", err)
+		writeError(c, rpc.ErrorCode_INVALID_ARGUMENT, "Failed to parse request body: %v", err)
+		return
+	}
+	if !asCaller(c, &req.Sender) {
+		return
+	}
+	// Clients retrying a Send after a timeout pass the same idempotency key,
+	// so that the message is not sent twice. Retries are not charged again
+	// by the rpc-server, which leaves sends with a key to its own limits.
+	key := req.IdempotencyKey
+	if h := c.GetHeader("Idempotency-Key"); len(h) > 0 {
+		key = string(h)
+	}
+	if key == "" && !limits.allowSend(c, req.Sender, req.Chat) {
+		return
+	}
+	attachment, code, err := sentAttachment(ctx, req.Attachment, req.Sender)
+	if err != nil {
+		writeError(c, code, err.Error())
 		return
 	}
 	resp, err := cli.Send(ctx, &rpc.SendRequest{
 		Message: &rpc.Message{
-			Chat:   req.Chat,
-			Text:   req.Text,
-			Sender: req.Sender,
+			Chat:       req.Chat,
+			Text:       req.Text,
+			Sender:     req.Sender,
+			ReplyTo:    req.ReplyTo,
+			Type:       rpc.ContentType(req.Type),
+			Attachment: attachment,
 		},
+		IdempotencyKey: &key,
 	})
 	if err != nil {
-		c.String(consts.StatusInternalServerError, err.Error())
+		writeCallError(c, err)
 	} else if resp.Code != 0 {
-		c.String(consts.StatusInternalServerError, resp.Msg)
+		if resp.Code == int32(rpc.ErrorCode_RATE_LIMITED) {
+			setRetryAfter(c, time.Duration(resp.GetRetryAfterMs())*time.Millisecond)
+		}
+		writeRPCError(c, resp.Code, resp.Msg)
 	} else {
-		c.Status(consts.StatusOK)
+		render(c, consts.StatusOK, &api.SendResponse{
+			Id:       resp.GetID(),
+			SendTime: resp.GetSendTime(),
+		})
 	}
 }
 
 func pullMessage(ctx context.Context, c *app.RequestContext) {
 	var req api.PullRequest
-	err := c.Bind(&req)
+	err := bind(c, &req)
 	if err != nil {
-		c.String(consts.StatusBadRequest, "Failed to parse request body: %v", err)
+		writeError(c, rpc.ErrorCode_INVALID_ARGUMENT, "Failed to parse request body: %v", err)
+		return
+	}
+	if !asCaller(c, &req.User) || !limits.allowPull(c, req.User, req.Chat) {
 		return
 	}
 
+	// A long polling Pull holds the call for up to WaitMs on top of the usual
+	// RPC timeout.
+	timeout := rpcTimeout + time.Duration(req.WaitMs)*time.Millisecond
 	resp, err := cli.Pull(ctx, &rpc.PullRequest{
 		Chat:    req.Chat,
 		Cursor:  req.Cursor,
 		Limit:   req.Limit,
 		Reverse: &req.Reverse,
-	})
+		User:    &req.User,
+		WaitMs:  &req.WaitMs,
+		Changes: &req.Changes,
+	}, callopt.WithRPCTimeout(timeout))
 	if err != nil {
-		c.String(consts.StatusInternalServerError, err.Error())
+		writeCallError(c, err)
 		return
 	} else if resp.Code != 0 {
-		c.String(consts.StatusInternalServerError, resp.Msg)
+		if resp.Code == int32(rpc.ErrorCode_RATE_LIMITED) {
+			setRetryAfter(c, time.Duration(resp.GetRetryAfterMs())*time.Millisecond)
+		}
+		writeRPCError(c, resp.Code, resp.Msg)
 		return
 	}
 	messages := make([]*api.Message, 0, len(resp.Messages))
 	for _, msg := range resp.Messages {
-		messages = append(messages, &api.Message{
-			Chat:     msg.Chat,
-			Text:     msg.Text,
-			Sender:   msg.Sender,
-			SendTime: msg.SendTime,
-		})
+		messages = append(messages, newAPIMessage(msg))
+	}
+	positions := make([]*api.ReadPosition, 0, len(resp.ReadPositions))
+	for _, pos := range resp.ReadPositions {
+		positions = append(positions, &api.ReadPosition{User: pos.User, MessageId: pos.MessageID})
+	}
+	render(c, consts.StatusOK, &api.PullResponse{
+		Messages:      messages,
+		HasMore:       resp.GetHasMore(),
+		NextCursor:    resp.GetNextCursor(),
+		ReadPositions: positions,
+	})
+}
+
+func createChat(ctx context.Context, c *app.RequestContext) {
+	var req api.CreateChatRequest
+	err := c.Bind(&req)
+	if err != nil {
+		writeError(c, rpc.ErrorCode_INVALID_ARGUMENT, "Failed to parse request body: %v", err)
+		return
+	}
+	if !asCaller(c, &req.Creator) {
+		return
+	}
+	resp, err := cli.CreateChat(ctx, &rpc.CreateChatRequest{
+		Creator: req.Creator,
+		Members: req.Members,
+	})
+	if err != nil {
+		writeCallError(c, err)
+	} else if resp.Code != 0 {
+		writeRPCError(c, resp.Code, resp.Msg)
+	} else {
+		c.JSON(consts.StatusOK, &api.CreateChatResponse{Chat: resp.GetChat()})
+	}
+}
+
+func addMembers(ctx context.Context, c *app.RequestContext) {
+	var req api.AddMembersRequest
+	err := c.Bind(&req)
+	if err != nil {
+		writeError(c, rpc.ErrorCode_INVALID_ARGUMENT, "Failed to parse request body: %v", err)
+		return
+	}
+	if !asCaller(c, &req.Operator) {
+		return
+	}
+	resp, err := cli.AddMembers(ctx, &rpc.AddMembersRequest{
+		Chat:     req.Chat,
+		Operator: req.Operator,
+		Members:  req.Members,
+	})
+	if err != nil {
+		writeCallError(c, err)
+	} else if resp.Code != 0 {
+		writeRPCError(c, resp.Code, resp.Msg)
+	} else {
+		c.JSON(consts.StatusOK, &api.AddMembersResponse{})
+	}
+}
+
+func removeMembers(ctx context.Context, c *app.RequestContext) {
+	var req api.RemoveMembersRequest
+	err := c.Bind(&req)
+	if err != nil {
+		writeError(c, rpc.ErrorCode_INVALID_ARGUMENT, "Failed to parse request body: %v", err)
+		return
+	}
+	if !asCaller(c, &req.Operator) {
+		return
+	}
+	resp, err := cli.RemoveMembers(ctx, &rpc.RemoveMembersRequest{
+		Chat:     req.Chat,
+		Operator: req.Operator,
+		Members:  req.Members,
+	})
+	if err != nil {
+		writeCallError(c, err)
+	} else if resp.Code != 0 {
+		writeRPCError(c, resp.Code, resp.Msg)
+	} else {
+		c.JSON(consts.StatusOK, &api.RemoveMembersResponse{})
 	}
-	c.JSON(consts.StatusOK, &api.PullResponse{
-		Messages:   messages,
+}
+
+func listMembers(ctx context.Context, c *app.RequestContext) {
+	var req api.ListMembersRequest
+	err := c.Bind(&req)
+	if err != nil {
+		writeError(c, rpc.ErrorCode_INVALID_ARGUMENT, "Failed to parse request body: %v", err)
+		return
+	}
+	if !asCaller(c, &req.Operator) {
+		return
+	}
+	resp, err := cli.ListMembers(ctx, &rpc.ListMembersRequest{
+		Chat:     req.Chat,
+		Operator: req.Operator,
+	})
+	if err != nil {
+		writeCallError(c, err)
+	} else if resp.Code != 0 {
+		writeRPCError(c, resp.Code, resp.Msg)
+	} else {
+		c.JSON(consts.StatusOK, &api.ListMembersResponse{Members: resp.Members})
+	}
+}
+
+func listChats(ctx context.Context, c *app.RequestContext) {
+	var req api.ListChatsRequest
+	err := c.Bind(&req)
+	if err != nil {
+		writeError(c, rpc.ErrorCode_INVALID_ARGUMENT, "Failed to parse request body: %v", err)
+		return
+	}
+	if !asCaller(c, &req.User) {
+		return
+	}
+	resp, err := cli.ListChats(ctx, &rpc.ListChatsRequest{
+		User:   req.User,
+		Cursor: req.Cursor,
+		Limit:  req.Limit,
+	})
+	if err != nil {
+		writeCallError(c, err)
+		return
+	} else if resp.Code != 0 {
+		writeRPCError(c, resp.Code, resp.Msg)
+		return
+	}
+	chats := make([]*api.ChatSummary, 0, len(resp.Chats))
+	for _, chat := range resp.Chats {
+		summary := &api.ChatSummary{
+			Chat:        chat.Chat,
+			ActiveTime:  chat.ActiveTime,
+			UnreadCount: chat.UnreadCount,
+		}
+		if chat.LastMessage != nil {
+			summary.LastMessage = newAPIMessage(chat.LastMessage)
+		}
+		chats = append(chats, summary)
+	}
+	c.JSON(consts.StatusOK, &api.ListChatsResponse{
+		Chats:      chats,
 		HasMore:    resp.GetHasMore(),
 		NextCursor: resp.GetNextCursor(),
 	})
 }
+
+func markRead(ctx context.Context, c *app.RequestContext) {
+	var req api.MarkReadRequest
+	err := c.Bind(&req)
+	if err != nil {
+		writeError(c, rpc.ErrorCode_INVALID_ARGUMENT, "Failed to parse request body: %v", err)
+		return
+	}
+	if !asCaller(c, &req.User) {
+		return
+	}
+	resp, err := cli.MarkRead(ctx, &rpc.MarkReadRequest{
+		Chat:      req.Chat,
+		User:      req.User,
+		MessageID: req.MessageId,
+	})
+	if err != nil {
+		writeCallError(c, err)
+	} else if resp.Code != 0 {
+		writeRPCError(c, resp.Code, resp.Msg)
+	} else {
+		c.JSON(consts.StatusOK, &api.MarkReadResponse{})
+	}
+}
+
+// getenv returns the value of the environment variable key, or fallback if
+// it is not set.
+func getenv(key, fallback string) string {
+	if v, ok := os.LookupEnv(key); ok {
+		return v
+	}
+	return fallback
+}
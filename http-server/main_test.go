@@ -0,0 +1,97 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"testing"
+	"time"
+
+	"github.com/TikTokTechImmersion/assignment_demo_2023/http-server/kitex_gen/rpc"
+	"github.com/TikTokTechImmersion/assignment_demo_2023/http-server/kitex_gen/rpc/imservice"
+	"github.com/cloudwego/hertz/pkg/app/server"
+	"github.com/cloudwego/hertz/pkg/common/config"
+	"github.com/cloudwego/hertz/pkg/common/ut"
+	"github.com/cloudwego/hertz/pkg/route"
+	"github.com/cloudwego/kitex/client/callopt"
+	"github.com/golang-jwt/jwt/v4"
+)
+
+// testKeyID is the ID of testSecret in the key set of the tests.
+const testKeyID = "test"
+
+var testSecret = []byte("0123456789abcdef0123456789abcdef")
+
+// testKeySet returns a JSON Web Key Set with the symmetric key secret.
+func testKeySet(kid string, secret []byte) []byte {
+	return []byte(`{"keys":[{"kty":"oct","kid":"` + kid + `","alg":"HS256","k":"` + base64.RawURLEncoding.EncodeToString(secret) + `"}]}`)
+}
+
+// testToken returns a token of user signed by secret with key ID kid, expiring
+// after ttl.
+func testToken(t *testing.T, user, kid string, secret []byte, ttl time.Duration) string {
+	token := jwt.NewWithClaims(jwt.SigningMethodHS256, jwt.RegisteredClaims{
+		Subject:   user,
+		ExpiresAt: jwt.NewNumericDate(time.Now().Add(ttl)),
+	})
+	token.Header["kid"] = kid
+	s, err := token.SignedString(secret)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return s
+}
+
+// newTestEngine returns an engine behind the middlewares of the http-server,
+// taking the tokens of testToken signed by testSecret, without rate limits.
+func newTestEngine(t *testing.T) *route.Engine {
+	keys, err := parseKeySet(testKeySet(testKeyID, testSecret))
+	if err != nil {
+		t.Fatal(err)
+	}
+	auth = &authenticator{keys: keys}
+	limits = &rateLimits{full: make(map[string]time.Time)}
+	e := route.NewEngine(config.NewOptions([]config.Option{server.WithStreamBody(true)}))
+	e.Use(requestID, authenticate, limitBody)
+	return e
+}
+
+// performRequest performs a request to e as user, with an empty user
+// performing it without a token.
+func performRequest(t *testing.T, e *route.Engine, method, url, user string, body []byte, headers ...ut.Header) *ut.ResponseRecorder {
+	if user != "" {
+		headers = append(headers, ut.Header{Key: "Authorization", Value: "Bearer " + testToken(t, user, testKeyID, testSecret, time.Minute)})
+	}
+	return ut.PerformRequest(e, method, url, &ut.Body{Body: bytes.NewReader(body), Len: len(body)}, headers...)
+}
+
+// fakeIMService is an IMService answering Send, Pull, BatchSend,
+// ReleasedBlobs and RegisterBlob with the functions it is given.
+type fakeIMService struct {
+	imservice.Client
+	send          func(req *rpc.SendRequest) *rpc.SendResponse
+	pull          func(req *rpc.PullRequest) *rpc.PullResponse
+	batchSend     func(req *rpc.BatchSendRequest) *rpc.BatchSendResponse
+	releasedBlobs func(req *rpc.ReleasedBlobsRequest) *rpc.ReleasedBlobsResponse
+	registerBlob  func(req *rpc.RegisterBlobRequest) *rpc.RegisterBlobResponse
+}
+
+func (s *fakeIMService) Send(ctx context.Context, req *rpc.SendRequest, callOptions ...callopt.Option) (*rpc.SendResponse, error) {
+	return s.send(req), nil
+}
+
+func (s *fakeIMService) Pull(ctx context.Context, req *rpc.PullRequest, callOptions ...callopt.Option) (*rpc.PullResponse, error) {
+	return s.pull(req), nil
+}
+
+func (s *fakeIMService) BatchSend(ctx context.Context, req *rpc.BatchSendRequest, callOptions ...callopt.Option) (*rpc.BatchSendResponse, error) {
+	return s.batchSend(req), nil
+}
+
+func (s *fakeIMService) ReleasedBlobs(ctx context.Context, req *rpc.ReleasedBlobsRequest, callOptions ...callopt.Option) (*rpc.ReleasedBlobsResponse, error) {
+	return s.releasedBlobs(req), nil
+}
+
+func (s *fakeIMService) RegisterBlob(ctx context.Context, req *rpc.RegisterBlobRequest, callOptions ...callopt.Option) (*rpc.RegisterBlobResponse, error) {
+	return s.registerBlob(req), nil
+}
@@ -0,0 +1,141 @@
+package main
+
+import (
+	"context"
+	"mime"
+	"strconv"
+	"strings"
+
+	"github.com/TikTokTechImmersion/assignment_demo_2023/http-server/kitex_gen/rpc"
+	"github.com/cloudwego/hertz/pkg/app"
+	"github.com/cloudwego/hertz/pkg/protocol/consts"
+	"google.golang.org/protobuf/proto"
+)
+
+// protobufType is the media type of bodies encoded as the messages of
+// idl_http.proto, more compact than JSON.
+const protobufType = "application/x-protobuf"
+
+// boundTypes are the media types of the request bodies read by c.Bind.
+var boundTypes = map[string]bool{
+	"application/json":                  true,
+	"application/x-www-form-urlencoded": true,
+	"multipart/form-data":               true,
+}
+
+// negotiate rejects the requests of the routes taking protobuf bodies whose
+// Content-Type is not read by bind, and those whose Accept header takes
+// neither JSON nor protobuf responses.
+func negotiate(ctx context.Context, c *app.RequestContext) {
+	if t := string(c.ContentType()); len(c.Request.Body()) > 0 && !isProtobuf(t) && !isBound(t) {
+		c.Abort()
+		render(c, consts.StatusUnsupportedMediaType, newAPIError(c, rpc.ErrorCode_INVALID_ARGUMENT,
+			"Unsupported Content-Type %q, expected application/json or %s", t, protobufType))
+		return
+	}
+	if !acceptable(c) {
+		c.Abort()
+		render(c, consts.StatusNotAcceptable, newAPIError(c, rpc.ErrorCode_INVALID_ARGUMENT,
+			"Unacceptable Accept %q, responses are application/json or %s", c.GetHeader("Accept"), protobufType))
+		return
+	}
+	c.Next(ctx)
+}
+
+// bind parses the body of a request into req, as protobuf if its
+// Content-Type is protobufType and as by c.Bind otherwise.
+func bind(c *app.RequestContext, req proto.Message) error {
+	if isProtobuf(string(c.ContentType())) {
+		return proto.Unmarshal(c.Request.Body(), req)
+	}
+	return c.Bind(req)
+}
+
+// render responds with status and resp, encoded as protobuf if the client
+// prefers it, see wantsProtobuf, and as JSON otherwise.
+func render(c *app.RequestContext, status int, resp proto.Message) {
+	c.Header("Vary", "Accept")
+	if !wantsProtobuf(c) {
+		c.JSON(status, resp)
+		return
+	}
+	b, err := proto.Marshal(resp)
+	if err != nil {
+		c.String(consts.StatusInternalServerError, err.Error())
+		return
+	}
+	c.Data(status, protobufType, b)
+}
+
+// acceptable reports whether the Accept header of a request takes JSON or
+// protobuf responses. Requests without the header, or with none of it
+// parsed, take both.
+func acceptable(c *app.RequestContext) bool {
+	accept := strings.TrimSpace(string(c.GetHeader("Accept")))
+	if accept == "" {
+		return true
+	}
+	parsed := false
+	for _, r := range strings.Split(accept, ",") {
+		t, q, ok := parseMediaRange(r)
+		if !ok {
+			continue
+		}
+		parsed = true
+		if q > 0 && (t == "*/*" || t == "application/*" || t == "application/json" || t == protobufType) {
+			return true
+		}
+	}
+	return !parsed
+}
+
+// wantsProtobuf reports whether the client prefers protobuf responses, its
+// Accept header ranking protobufType above JSON. If it ranks neither of them,
+// e.g. with no header or "*/*", responses are encoded as the request was.
+func wantsProtobuf(c *app.RequestContext) bool {
+	var protobufQ, jsonQ float64
+	for _, r := range strings.Split(string(c.GetHeader("Accept")), ",") {
+		t, q, ok := parseMediaRange(r)
+		if !ok {
+			continue
+		}
+		switch {
+		case t == protobufType && q > protobufQ:
+			protobufQ = q
+		case t == "application/json" && q > jsonQ:
+			jsonQ = q
+		}
+	}
+	if protobufQ != jsonQ {
+		return protobufQ > jsonQ
+	}
+	return isProtobuf(string(c.ContentType()))
+}
+
+// parseMediaRange parses r, a media range of an Accept header, returning its
+// type and quality. ok is false if r is malformed.
+func parseMediaRange(r string) (t string, q float64, ok bool) {
+	t, params, err := mime.ParseMediaType(strings.TrimSpace(r))
+	if err != nil {
+		return "", 0, false
+	}
+	q = 1.0
+	if s, ok := params["q"]; ok {
+		if q, err = strconv.ParseFloat(s, 64); err != nil {
+			return "", 0, false
+		}
+	}
+	return t, q, true
+}
+
+// isProtobuf reports whether contentType is protobufType.
+func isProtobuf(contentType string) bool {
+	t, _, _ := mime.ParseMediaType(contentType)
+	return t == protobufType
+}
+
+// isBound reports whether contentType is one of boundTypes.
+func isBound(contentType string) bool {
+	t, _, _ := mime.ParseMediaType(contentType)
+	return boundTypes[t]
+}
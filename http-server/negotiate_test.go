@@ -0,0 +1,171 @@
+package main
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/TikTokTechImmersion/assignment_demo_2023/http-server/kitex_gen/rpc"
+	"github.com/TikTokTechImmersion/assignment_demo_2023/http-server/proto_gen/api"
+	"github.com/cloudwego/hertz/pkg/app"
+	"github.com/cloudwego/hertz/pkg/common/ut"
+	"github.com/cloudwego/hertz/pkg/protocol/consts"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/protobuf/proto"
+)
+
+func TestWantsProtobuf(t *testing.T) {
+	tests := []struct {
+		name        string
+		accept      string
+		contentType string
+		want        bool
+	}{
+		{name: "no header", want: false},
+		{name: "no header, protobuf request", contentType: protobufType, want: true},
+		{name: "protobuf", accept: protobufType, want: true},
+		{name: "json", accept: "application/json", contentType: protobufType, want: false},
+		{name: "protobuf preferred", accept: "application/json;q=0.5, " + protobufType, want: true},
+		{name: "json preferred", accept: protobufType + ";q=0.8, application/json;q=0.9", contentType: protobufType, want: false},
+		{name: "highest q counts", accept: protobufType + ";q=0.1, application/json;q=0.5, " + protobufType + ";q=0.9", want: true},
+		{name: "tie, json request", accept: protobufType + ", application/json", contentType: "application/json", want: false},
+		{name: "tie, protobuf request", accept: protobufType + ", application/json", contentType: protobufType + "; charset=binary", want: true},
+		{name: "wildcard", accept: "*/*", contentType: protobufType, want: true},
+		{name: "malformed q", accept: protobufType + ";q=high", want: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := app.NewContext(0)
+			if tt.accept != "" {
+				c.Request.Header.Set("Accept", tt.accept)
+			}
+			if tt.contentType != "" {
+				c.Request.Header.SetContentTypeBytes([]byte(tt.contentType))
+			}
+			assert.Equal(t, tt.want, wantsProtobuf(c))
+		})
+	}
+}
+
+func TestNegotiate(t *testing.T) {
+	e := newTestEngine(t)
+	e.POST("/api/send", negotiate, sendMessage)
+	e.GET("/api/pull", negotiate, pullMessage)
+	cli = &fakeIMService{
+		send: func(req *rpc.SendRequest) *rpc.SendResponse {
+			id, sendTime := int64(1), int64(100)
+			if req.Message.Text == "" {
+				return &rpc.SendResponse{Code: int32(rpc.ErrorCode_INVALID_ARGUMENT), Msg: "empty message"}
+			}
+			return &rpc.SendResponse{ID: &id, SendTime: &sendTime}
+		},
+		pull: func(req *rpc.PullRequest) *rpc.PullResponse {
+			return &rpc.PullResponse{Messages: []*rpc.Message{{Chat: req.Chat, Text: "hi", Sender: req.GetUser(), ID: 1, SendTime: 100}}}
+		},
+	}
+	header := func(key, value string) ut.Header {
+		return ut.Header{Key: key, Value: value}
+	}
+	mustMarshal := func(m proto.Message) []byte {
+		b, err := proto.Marshal(m)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return b
+	}
+	// decode decodes the body of w as protobuf or JSON depending on its
+	// Content-Type.
+	decode := func(t *testing.T, w *ut.ResponseRecorder, resp proto.Message) {
+		if isProtobuf(string(w.Header().ContentType())) {
+			assert.NoError(t, proto.Unmarshal(w.Body.Bytes(), resp))
+		} else {
+			assert.NoError(t, json.Unmarshal(w.Body.Bytes(), resp))
+		}
+	}
+
+	t.Run("send", func(t *testing.T) {
+		send := &api.SendRequest{Chat: "doe:john", Text: "hi"}
+		jsonBody, _ := json.Marshal(send)
+		for _, tt := range []struct {
+			name         string
+			body         []byte
+			headers      []ut.Header
+			wantProtobuf bool
+		}{
+			{name: "json", body: jsonBody, headers: []ut.Header{header("Content-Type", "application/json")}},
+			{name: "protobuf", body: mustMarshal(send), headers: []ut.Header{header("Content-Type", protobufType)}, wantProtobuf: true},
+			{name: "protobuf to json", body: mustMarshal(send), headers: []ut.Header{header("Content-Type", protobufType), header("Accept", "application/json")}},
+			{name: "json to protobuf", body: jsonBody, headers: []ut.Header{header("Content-Type", "application/json"), header("Accept", protobufType)}, wantProtobuf: true},
+		} {
+			t.Run(tt.name, func(t *testing.T) {
+				w := performRequest(t, e, consts.MethodPost, "/api/send", "doe", tt.body, tt.headers...)
+				assert.Equal(t, consts.StatusOK, w.Code)
+				assert.Equal(t, tt.wantProtobuf, isProtobuf(string(w.Header().ContentType())))
+				assert.Equal(t, "Accept", string(w.Header().Peek("Vary")))
+				var resp api.SendResponse
+				decode(t, w, &resp)
+				assert.Equal(t, int64(1), resp.Id)
+				assert.Equal(t, int64(100), resp.SendTime)
+			})
+		}
+	})
+
+	t.Run("pull", func(t *testing.T) {
+		pull := &api.PullRequest{Chat: "doe:john"}
+		jsonBody, _ := json.Marshal(pull)
+		for _, tt := range []struct {
+			name         string
+			body         []byte
+			contentType  string
+			wantProtobuf bool
+		}{
+			{name: "json", body: jsonBody, contentType: "application/json"},
+			{name: "protobuf", body: mustMarshal(pull), contentType: protobufType, wantProtobuf: true},
+		} {
+			t.Run(tt.name, func(t *testing.T) {
+				w := performRequest(t, e, consts.MethodGet, "/api/pull", "doe", tt.body, header("Content-Type", tt.contentType))
+				assert.Equal(t, consts.StatusOK, w.Code)
+				assert.Equal(t, tt.wantProtobuf, isProtobuf(string(w.Header().ContentType())))
+				var resp api.PullResponse
+				decode(t, w, &resp)
+				assert.Len(t, resp.Messages, 1)
+				assert.Equal(t, "doe:john", resp.Messages[0].Chat)
+				assert.Equal(t, "doe", resp.Messages[0].Sender)
+				assert.Equal(t, "hi", resp.Messages[0].Text)
+			})
+		}
+	})
+
+	t.Run("errors", func(t *testing.T) {
+		body := mustMarshal(&api.SendRequest{Chat: "doe:john"})
+		w := performRequest(t, e, consts.MethodPost, "/api/send", "doe", body, header("Content-Type", protobufType))
+		assert.Equal(t, consts.StatusBadRequest, w.Code)
+		assert.True(t, isProtobuf(string(w.Header().ContentType())))
+		var resp api.Error
+		decode(t, w, &resp)
+		assert.Equal(t, "INVALID_ARGUMENT", resp.Code)
+		assert.Equal(t, "empty message", resp.Message)
+	})
+
+	t.Run("unsupported media type", func(t *testing.T) {
+		w := performRequest(t, e, consts.MethodPost, "/api/send", "doe", []byte("hi"), header("Content-Type", "text/plain"))
+		assert.Equal(t, consts.StatusUnsupportedMediaType, w.Code)
+		var resp api.Error
+		decode(t, w, &resp)
+		assert.Equal(t, "INVALID_ARGUMENT", resp.Code)
+		assert.Contains(t, resp.Message, `"text/plain"`)
+	})
+
+	t.Run("not acceptable", func(t *testing.T) {
+		for _, accept := range []string{"text/html", "application/xml, " + protobufType + ";q=0"} {
+			w := performRequest(t, e, consts.MethodGet, "/api/pull?chat=doe:john", "doe", nil, header("Accept", accept))
+			assert.Equal(t, consts.StatusNotAcceptable, w.Code, accept)
+			var resp api.Error
+			decode(t, w, &resp)
+			assert.Equal(t, "INVALID_ARGUMENT", resp.Code)
+		}
+		for _, accept := range []string{"text/html, */*;q=0.1", "application/*", "not a media type"} {
+			w := performRequest(t, e, consts.MethodGet, "/api/pull?chat=doe:john", "doe", nil, header("Accept", accept))
+			assert.Equal(t, consts.StatusOK, w.Code, accept)
+		}
+	})
+}
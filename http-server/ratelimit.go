@@ -0,0 +1,166 @@
+package main
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/TikTokTechImmersion/assignment_demo_2023/http-server/kitex_gen/rpc"
+	"github.com/cloudwego/hertz/pkg/app"
+)
+
+// limits are the rate limits of the sends and pulls of this http-server. They
+// mirror the limits enforced by the rpc-server, to reject floods before they
+// reach it, but every http-server keeps buckets of its own.
+var limits *rateLimits
+
+// localLimiterSweep is how many takes a rateLimits makes between sweeps of
+// its full buckets.
+const localLimiterSweep = 1024
+
+// limit is a token bucket holding up to burst tokens and refilled with burst
+// tokens every per. Every request takes a token. The zero limit is unlimited.
+type limit struct {
+	burst int
+	per   time.Duration
+}
+
+// parseLimit parses a limit of the form "<burst>/<per>", e.g. "10/1s". The
+// empty string is unlimited.
+func parseLimit(s string) (limit, error) {
+	if s == "" {
+		return limit{}, nil
+	}
+	burst, per, ok := strings.Cut(s, "/")
+	n, err := strconv.Atoi(burst)
+	if !ok || err != nil || n <= 0 {
+		return limit{}, fmt.Errorf("invalid limit %q, expected format \"<requests>/<duration>\"", s)
+	}
+	d, err := time.ParseDuration(per)
+	if err != nil || d <= 0 {
+		return limit{}, fmt.Errorf("invalid limit %q, expected format \"<requests>/<duration>\"", s)
+	}
+	return limit{burst: n, per: d}, nil
+}
+
+// rateLimits keeps the buckets of every user and chat as the time at which
+// they are full again, forgetting the full ones.
+type rateLimits struct {
+	sendUser, sendChat limit
+	pullUser, pullChat limit
+
+	mu    sync.Mutex
+	full  map[string]time.Time // by bucket key, only the buckets not full
+	takes int
+}
+
+// loadRateLimits returns the rate limits configured by the SEND_LIMIT_PER_USER,
+// SEND_LIMIT_PER_CHAT, PULL_LIMIT_PER_USER and PULL_LIMIT_PER_CHAT
+// environment variables, with the defaults of the rpc-server.
+func loadRateLimits() (*rateLimits, error) {
+	l := &rateLimits{full: make(map[string]time.Time)}
+	for _, v := range []struct {
+		env, def string
+		limit    *limit
+	}{
+		{"SEND_LIMIT_PER_USER", "20/1s", &l.sendUser},
+		{"SEND_LIMIT_PER_CHAT", "100/1s", &l.sendChat},
+		{"PULL_LIMIT_PER_USER", "50/1s", &l.pullUser},
+		{"PULL_LIMIT_PER_CHAT", "200/1s", &l.pullChat},
+	} {
+		lim, err := parseLimit(getenv(v.env, v.def))
+		if err != nil {
+			return nil, fmt.Errorf("invalid %s: %w", v.env, err)
+		}
+		*v.limit = lim
+	}
+	return l, nil
+}
+
+// allowSend takes a token from the buckets of user and chat for sending a
+// message. If one of them is empty, it rejects the request and returns false.
+func (l *rateLimits) allowSend(c *app.RequestContext, user, chat string) bool {
+	chat = chatKey(chat)
+	return l.allow(c, user, "send_user:"+user, l.sendUser, fmt.Sprintf("user %q sends too many messages", user)) &&
+		l.allow(c, chat, "send_chat:"+chat, l.sendChat, fmt.Sprintf("chat %q receives too many messages", chat))
+}
+
+// allowPull takes a token from the bucket of user, and from the one of chat
+// if set, for pulling messages. If one of them is empty, it rejects the
+// request and returns false.
+func (l *rateLimits) allowPull(c *app.RequestContext, user, chat string) bool {
+	if !l.allow(c, user, "pull_user:"+user, l.pullUser, fmt.Sprintf("user %q pulls too often", user)) {
+		return false
+	}
+	chat = chatKey(chat)
+	return l.allow(c, chat, "pull_chat:"+chat, l.pullChat, fmt.Sprintf("chat %q is pulled too often", chat))
+}
+
+// allow takes a token from the bucket of key, unless id is empty. If the
+// bucket is empty, it rejects the request with msg and returns false.
+func (l *rateLimits) allow(c *app.RequestContext, id, key string, lim limit, msg string) bool {
+	if id == "" || lim.burst <= 0 {
+		return true
+	}
+	if wait := l.take(key, lim, time.Now()); wait > 0 {
+		tooManyRequests(c, wait, msg)
+		return false
+	}
+	return true
+}
+
+// take takes a token from the bucket of key at now, and returns how long
+// until the bucket holds one if it is empty.
+func (l *rateLimits) take(key string, lim limit, now time.Time) time.Duration {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if l.takes++; l.takes%localLimiterSweep == 0 {
+		for k, full := range l.full {
+			if !full.After(now) {
+				delete(l.full, k)
+			}
+		}
+	}
+	full := l.full[key]
+	if full.Before(now) {
+		full = now
+	}
+	interval := lim.per / time.Duration(lim.burst)
+	if interval <= 0 {
+		interval = 1
+	}
+	full = full.Add(interval)
+	if wait := full.Sub(now) - lim.per; wait > 0 {
+		return wait
+	}
+	l.full[key] = full
+	return 0
+}
+
+// chatKey returns the canonical form of chat, listing the members of direct
+// chats in lexical order as the rpc-server does, so that clients cannot
+// double their limits by swapping them.
+func chatKey(chat string) string {
+	members := strings.Split(chat, ":")
+	if len(members) != 2 {
+		return chat
+	}
+	sort.Strings(members)
+	return members[0] + ":" + members[1]
+}
+
+// tooManyRequests rejects a request as RATE_LIMITED, telling the client to
+// retry after wait.
+func tooManyRequests(c *app.RequestContext, wait time.Duration, msg string) {
+	setRetryAfter(c, wait)
+	writeError(c, rpc.ErrorCode_RATE_LIMITED, msg)
+}
+
+// setRetryAfter sets the Retry-After header to wait, rounded up to whole
+// seconds so that clients do not retry too early.
+func setRetryAfter(c *app.RequestContext, wait time.Duration) {
+	c.Header("Retry-After", strconv.FormatInt(int64((wait+time.Second-1)/time.Second), 10))
+}
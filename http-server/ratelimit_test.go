@@ -0,0 +1,179 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/TikTokTechImmersion/assignment_demo_2023/http-server/kitex_gen/rpc"
+	"github.com/TikTokTechImmersion/assignment_demo_2023/http-server/proto_gen/api"
+	"github.com/cloudwego/hertz/pkg/app"
+	"github.com/cloudwego/hertz/pkg/common/ut"
+	"github.com/cloudwego/hertz/pkg/protocol/consts"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseLimit(t *testing.T) {
+	tests := []struct {
+		s       string
+		want    limit
+		wantErr bool
+	}{
+		{s: "", want: limit{}},
+		{s: "10/1s", want: limit{burst: 10, per: time.Second}},
+		{s: "3/1m30s", want: limit{burst: 3, per: 90 * time.Second}},
+		{s: "10", wantErr: true},
+		{s: "0/1s", wantErr: true},
+		{s: "-1/1s", wantErr: true},
+		{s: "10/0s", wantErr: true},
+		{s: "ten/1s", wantErr: true},
+		{s: "10/second", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.s, func(t *testing.T) {
+			got, err := parseLimit(tt.s)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestRateLimits_Take(t *testing.T) {
+	l := &rateLimits{full: make(map[string]time.Time)}
+	lim := limit{burst: 2, per: time.Second}
+	now := time.Now()
+	assert.Zero(t, l.take("a", lim, now))
+	assert.Zero(t, l.take("a", lim, now))
+	assert.Equal(t, 500*time.Millisecond, l.take("a", lim, now))
+	// Rejected takes do not count, other buckets are apart.
+	assert.Equal(t, 500*time.Millisecond, l.take("a", lim, now))
+	assert.Zero(t, l.take("b", lim, now))
+	// Tokens come back one every per/burst.
+	assert.Zero(t, l.take("a", lim, now.Add(500*time.Millisecond)))
+	assert.Equal(t, 500*time.Millisecond, l.take("a", lim, now.Add(500*time.Millisecond)))
+	assert.Zero(t, l.take("a", lim, now.Add(2*time.Second)))
+
+	// Full buckets are forgotten by sweeps.
+	for l.takes%localLimiterSweep != localLimiterSweep-1 {
+		l.takes++
+	}
+	l.take("c", lim, now.Add(time.Hour))
+	assert.Equal(t, []string{"c"}, func() []string {
+		var keys []string
+		for key := range l.full {
+			keys = append(keys, key)
+		}
+		return keys
+	}())
+}
+
+func TestRateLimits(t *testing.T) {
+	e := newTestEngine(t)
+	limits = &rateLimits{
+		sendUser: limit{burst: 2, per: time.Minute},
+		sendChat: limit{burst: 2, per: time.Minute},
+		pullUser: limit{burst: 2, per: time.Minute},
+		pullChat: limit{burst: 1, per: 2 * time.Second},
+		full:     make(map[string]time.Time),
+	}
+	e.POST("/send", func(ctx context.Context, c *app.RequestContext) {
+		if limits.allowSend(c, c.GetString(callerKey), c.Query("chat")) {
+			c.Status(consts.StatusOK)
+		}
+	})
+	e.GET("/pull", func(ctx context.Context, c *app.RequestContext) {
+		if limits.allowPull(c, c.GetString(callerKey), c.Query("chat")) {
+			c.Status(consts.StatusOK)
+		}
+	})
+	tests := []struct {
+		name           string
+		method         string
+		url            string
+		user           string
+		wantStatus     int
+		wantRetryAfter string
+		wantMessage    string
+	}{
+		{name: "send", method: consts.MethodPost, url: "/send?chat=doe:john", user: "doe", wantStatus: consts.StatusOK},
+		{name: "send again", method: consts.MethodPost, url: "/send?chat=doe:john", user: "doe", wantStatus: consts.StatusOK},
+		{name: "user limited", method: consts.MethodPost, url: "/send?chat=doe:john", user: "doe", wantStatus: consts.StatusTooManyRequests, wantRetryAfter: "30", wantMessage: `user "doe" sends too many messages`},
+		// Swapping the members of a direct chat does not reset its bucket.
+		{name: "chat limited", method: consts.MethodPost, url: "/send?chat=john:doe", user: "john", wantStatus: consts.StatusTooManyRequests, wantRetryAfter: "30", wantMessage: `chat "doe:john" receives too many messages`},
+		{name: "other chat", method: consts.MethodPost, url: "/send?chat=john:jane", user: "john", wantStatus: consts.StatusOK},
+		{name: "pull", method: consts.MethodGet, url: "/pull?chat=doe:john", user: "jane", wantStatus: consts.StatusOK},
+		{name: "pull chat limited", method: consts.MethodGet, url: "/pull?chat=john:doe", user: "jane", wantStatus: consts.StatusTooManyRequests, wantRetryAfter: "2", wantMessage: `chat "doe:john" is pulled too often`},
+		{name: "pull user limited", method: consts.MethodGet, url: "/pull", user: "jane", wantStatus: consts.StatusTooManyRequests, wantRetryAfter: "30", wantMessage: `user "jane" pulls too often`},
+		{name: "pull other user", method: consts.MethodGet, url: "/pull", user: "doe", wantStatus: consts.StatusOK},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			w := performRequest(t, e, tt.method, tt.url, tt.user, nil)
+			assert.Equal(t, tt.wantStatus, w.Code)
+			assert.Equal(t, tt.wantRetryAfter, string(w.Header().Peek("Retry-After")))
+			if tt.wantStatus == consts.StatusOK {
+				return
+			}
+			var body api.Error
+			assert.NoError(t, json.Unmarshal(w.Body.Bytes(), &body))
+			assert.Equal(t, "RATE_LIMITED", body.Code)
+			assert.Equal(t, tt.wantMessage, body.Message)
+			assert.True(t, body.Retryable)
+		})
+	}
+}
+
+func TestSendMessage_RateLimits(t *testing.T) {
+	e := newTestEngine(t)
+	e.POST("/api/send", sendMessage)
+	limits = &rateLimits{sendUser: limit{burst: 1, per: time.Minute}, full: make(map[string]time.Time)}
+	sent := 0
+	cli = &fakeIMService{send: func(req *rpc.SendRequest) *rpc.SendResponse {
+		sent++
+		return &rpc.SendResponse{Code: 0, Msg: "success"}
+	}}
+	send := func(headers ...ut.Header) int {
+		headers = append(headers, ut.Header{Key: "Content-Type", Value: "application/json"})
+		return performRequest(t, e, consts.MethodPost, "/api/send", "doe", []byte(`{"chat":"doe:john","text":"hi"}`), headers...).Code
+	}
+	assert.Equal(t, consts.StatusOK, send())
+	assert.Equal(t, consts.StatusTooManyRequests, send())
+	// Sends with an idempotency key are left to the limits of the rpc-server,
+	// which does not charge retries.
+	key := ut.Header{Key: "Idempotency-Key", Value: "k1"}
+	assert.Equal(t, consts.StatusOK, send(key))
+	assert.Equal(t, consts.StatusOK, send(key))
+	assert.Equal(t, 3, sent)
+}
+
+func TestBatchSend_RateLimits(t *testing.T) {
+	e := newTestEngine(t)
+	e.POST("/api/batch_send", batchSend)
+	limits = &rateLimits{sendChat: limit{burst: 2, per: time.Minute}, full: make(map[string]time.Time)}
+	batches := 0
+	cli = &fakeIMService{batchSend: func(req *rpc.BatchSendRequest) *rpc.BatchSendResponse {
+		batches++
+		resps := make([]*rpc.SendResponse, len(req.Requests))
+		for i := range resps {
+			resps[i] = &rpc.SendResponse{Code: 0, Msg: "success"}
+		}
+		return &rpc.BatchSendResponse{Responses: resps}
+	}}
+	send := func(body string) *ut.ResponseRecorder {
+		return performRequest(t, e, consts.MethodPost, "/api/batch_send", "doe", []byte(body), ut.Header{Key: "Content-Type", Value: "application/json"})
+	}
+	w := send(`{"requests":[{"chat":"doe:john","text":"1"},{"chat":"doe:jane","text":"2"},{"chat":"john:doe","text":"3"}]}`)
+	assert.Equal(t, consts.StatusOK, w.Code)
+	// The batch is rejected as a whole once a message is rate limited.
+	w = send(`{"requests":[{"chat":"doe:jane","text":"4"},{"chat":"doe:john","text":"5"}]}`)
+	assert.Equal(t, consts.StatusTooManyRequests, w.Code)
+	assert.Equal(t, "30", string(w.Header().Peek("Retry-After")))
+	w = send(`{"requests":[{"chat":"doe:john","text":"5","idempotency_key":"k5"}]}`)
+	assert.Equal(t, consts.StatusOK, w.Code)
+	assert.Equal(t, 2, batches)
+}
@@ -0,0 +1,60 @@
+package main
+
+import (
+	"context"
+
+	"github.com/TikTokTechImmersion/assignment_demo_2023/http-server/kitex_gen/rpc"
+	"github.com/TikTokTechImmersion/assignment_demo_2023/http-server/proto_gen/api"
+	"github.com/cloudwego/hertz/pkg/app"
+	"github.com/cloudwego/hertz/pkg/protocol/consts"
+)
+
+func addReaction(ctx context.Context, c *app.RequestContext) {
+	var req api.AddReactionRequest
+	err := c.Bind(&req)
+	if err != nil {
+		writeError(c, rpc.ErrorCode_INVALID_ARGUMENT, "Failed to parse request body: %v", err)
+		return
+	}
+	if !asCaller(c, &req.User) {
+		return
+	}
+	resp, err := cli.AddReaction(ctx, &rpc.AddReactionRequest{
+		Chat:  req.Chat,
+		ID:    req.Id,
+		User:  req.User,
+		Emoji: req.Emoji,
+	})
+	if err != nil {
+		writeCallError(c, err)
+	} else if resp.Code != 0 {
+		writeRPCError(c, resp.Code, resp.Msg)
+	} else {
+		c.JSON(consts.StatusOK, &api.AddReactionResponse{Message: newAPIMessage(resp.Message)})
+	}
+}
+
+func removeReaction(ctx context.Context, c *app.RequestContext) {
+	var req api.RemoveReactionRequest
+	err := c.Bind(&req)
+	if err != nil {
+		writeError(c, rpc.ErrorCode_INVALID_ARGUMENT, "Failed to parse request body: %v", err)
+		return
+	}
+	if !asCaller(c, &req.User) {
+		return
+	}
+	resp, err := cli.RemoveReaction(ctx, &rpc.RemoveReactionRequest{
+		Chat:  req.Chat,
+		ID:    req.Id,
+		User:  req.User,
+		Emoji: req.Emoji,
+	})
+	if err != nil {
+		writeCallError(c, err)
+	} else if resp.Code != 0 {
+		writeRPCError(c, resp.Code, resp.Msg)
+	} else {
+		c.JSON(consts.StatusOK, &api.RemoveReactionResponse{Message: newAPIMessage(resp.Message)})
+	}
+}
@@ -0,0 +1,67 @@
+package main
+
+import (
+	"context"
+
+	"github.com/TikTokTechImmersion/assignment_demo_2023/http-server/kitex_gen/rpc"
+	"github.com/TikTokTechImmersion/assignment_demo_2023/http-server/proto_gen/api"
+	"github.com/cloudwego/hertz/pkg/app"
+	"github.com/cloudwego/hertz/pkg/protocol/consts"
+)
+
+func getRetention(ctx context.Context, c *app.RequestContext) {
+	var req api.GetRetentionRequest
+	err := c.Bind(&req)
+	if err != nil {
+		writeError(c, rpc.ErrorCode_INVALID_ARGUMENT, "Failed to parse request body: %v", err)
+		return
+	}
+	if !asCaller(c, &req.User) {
+		return
+	}
+	resp, err := cli.GetRetention(ctx, &rpc.GetRetentionRequest{
+		Chat: req.Chat,
+		User: req.User,
+	})
+	if err != nil {
+		writeCallError(c, err)
+	} else if resp.Code != 0 {
+		writeRPCError(c, resp.Code, resp.Msg)
+	} else {
+		r := resp.GetRetention()
+		c.JSON(consts.StatusOK, &api.GetRetentionResponse{Retention: &api.Retention{
+			MaxAge:         r.GetMaxAge(),
+			MaxCount:       r.GetMaxCount(),
+			DisappearAfter: r.GetDisappearAfter(),
+		}})
+	}
+}
+
+func setRetention(ctx context.Context, c *app.RequestContext) {
+	var req api.SetRetentionRequest
+	err := c.Bind(&req)
+	if err != nil {
+		writeError(c, rpc.ErrorCode_INVALID_ARGUMENT, "Failed to parse request body: %v", err)
+		return
+	}
+	if !asCaller(c, &req.User) {
+		return
+	}
+	r := req.GetRetention()
+	resp, err := cli.SetRetention(ctx, &rpc.SetRetentionRequest{
+		Chat: req.Chat,
+		User: req.User,
+		Retention: &rpc.Retention{
+			MaxAge:         r.GetMaxAge(),
+			MaxCount:       r.GetMaxCount(),
+			DisappearAfter: r.GetDisappearAfter(),
+		},
+	})
+	if err != nil {
+		writeCallError(c, err)
+	} else if resp.Code != 0 {
+		writeRPCError(c, resp.Code, resp.Msg)
+	} else {
+		c.JSON(consts.StatusOK, &api.SetRetentionResponse{})
+	}
+}
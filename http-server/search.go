@@ -0,0 +1,48 @@
+package main
+
+import (
+	"context"
+
+	"github.com/TikTokTechImmersion/assignment_demo_2023/http-server/kitex_gen/rpc"
+	"github.com/TikTokTechImmersion/assignment_demo_2023/http-server/proto_gen/api"
+	"github.com/cloudwego/hertz/pkg/app"
+	"github.com/cloudwego/hertz/pkg/protocol/consts"
+)
+
+func search(ctx context.Context, c *app.RequestContext) {
+	var req api.SearchRequest
+	err := c.Bind(&req)
+	if err != nil {
+		writeError(c, rpc.ErrorCode_INVALID_ARGUMENT, "Failed to parse request body: %v", err)
+		return
+	}
+	if !asCaller(c, &req.User) {
+		return
+	}
+	searchReq := &rpc.SearchRequest{
+		User:   req.User,
+		Query:  req.Query,
+		Cursor: req.Cursor,
+		Limit:  req.Limit,
+	}
+	if req.Chat != "" {
+		searchReq.Chat = &req.Chat
+	}
+	resp, err := cli.Search(ctx, searchReq)
+	if err != nil {
+		writeCallError(c, err)
+		return
+	} else if resp.Code != 0 {
+		writeRPCError(c, resp.Code, resp.Msg)
+		return
+	}
+	messages := make([]*api.Message, 0, len(resp.Messages))
+	for _, msg := range resp.Messages {
+		messages = append(messages, newAPIMessage(msg))
+	}
+	c.JSON(consts.StatusOK, &api.SearchResponse{
+		Messages:   messages,
+		HasMore:    resp.GetHasMore(),
+		NextCursor: resp.GetNextCursor(),
+	})
+}
@@ -0,0 +1,114 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/TikTokTechImmersion/assignment_demo_2023/http-server/kitex_gen/rpc"
+	"github.com/cloudwego/hertz/pkg/app"
+	"github.com/cloudwego/hertz/pkg/protocol/consts"
+	http1resp "github.com/cloudwego/hertz/pkg/protocol/http1/resp"
+	"github.com/cloudwego/kitex/client/callopt"
+)
+
+// sseKeepAlive is how long a stream stays silent before a keep-alive comment
+// is sent, which is also how long each Subscribe call waits.
+const sseKeepAlive = 15 * time.Second
+
+// streamMessages pushes the messages of a chat as Server-Sent Events. The
+// query parameters are chat, user and cursor, the send time to start from.
+// Every event carries the message ID, so a reconnecting client resumes right
+// after the last message it received through the Last-Event-ID header.
+func streamMessages(ctx context.Context, c *app.RequestContext) {
+	cursor := &rpc.ChatCursor{Chat: c.Query("chat")}
+	if s := c.Query("cursor"); s != "" {
+		n, err := strconv.ParseInt(s, 10, 64)
+		if err != nil {
+			writeError(c, rpc.ErrorCode_INVALID_ARGUMENT, "Invalid cursor: %v", err)
+			return
+		}
+		cursor.Cursor = n
+	}
+	if s := string(c.GetHeader("Last-Event-ID")); s != "" {
+		id, err := strconv.ParseInt(s, 10, 64)
+		if err != nil {
+			writeError(c, rpc.ErrorCode_INVALID_ARGUMENT, "Invalid Last-Event-ID: %v", err)
+			return
+		}
+		cursor.AfterID = &id
+	}
+	user := c.Query("user")
+	if !asCaller(c, &user) {
+		return
+	}
+
+	// The first call does not wait, so that a rejected subscription is still
+	// answered with a plain HTTP error.
+	var waitMs int32
+	for {
+		resp, err := cli.Subscribe(ctx, &rpc.SubscribeRequest{
+			User:   user,
+			Chats:  []*rpc.ChatCursor{cursor},
+			WaitMs: &waitMs,
+		}, callopt.WithRPCTimeout(rpcTimeout+sseKeepAlive))
+		if err != nil {
+			failSSE(c, rpc.ErrorCode_UNAVAILABLE, err.Error())
+			return
+		} else if resp.Code != 0 {
+			failSSE(c, errorCode(resp.Code), resp.Msg)
+			return
+		}
+		if c.Response.GetHijackWriter() == nil {
+			startSSE(c)
+		}
+		for _, msg := range resp.Messages {
+			data, err := json.Marshal(newAPIMessage(msg))
+			if err != nil {
+				return
+			}
+			if !writeSSE(c, []byte(fmt.Sprintf("id: %d\nevent: message\ndata: %s\n\n", msg.ID, data))) {
+				return
+			}
+		}
+		if len(resp.Messages) == 0 && waitMs > 0 {
+			// Keep idle streams from being dropped by proxies, and notice
+			// clients that went away.
+			if !writeSSE(c, []byte(": keep-alive\n\n")) {
+				return
+			}
+		}
+		cursor = resp.Cursors[0]
+		waitMs = int32(sseKeepAlive / time.Millisecond)
+	}
+}
+
+// startSSE sends the headers of an event stream, whose body is then written
+// in chunks as events arrive.
+func startSSE(c *app.RequestContext) {
+	c.SetStatusCode(consts.StatusOK)
+	c.SetContentType("text/event-stream")
+	c.Response.Header.Set("Cache-Control", "no-cache")
+	c.Response.HijackWriter(http1resp.NewChunkedBodyWriter(&c.Response, c.GetWriter()))
+	writeSSE(c, []byte(": connected\n\n"))
+}
+
+// failSSE reports an error with code before the stream starts, and as an
+// error event carrying an api.Error afterwards.
+func failSSE(c *app.RequestContext, code rpc.ErrorCode, msg string) {
+	if c.Response.GetHijackWriter() == nil {
+		writeError(c, code, msg)
+		return
+	}
+	data, _ := json.Marshal(newAPIError(c, code, msg))
+	writeSSE(c, []byte(fmt.Sprintf("event: error\ndata: %s\n\n", data)))
+}
+
+// writeSSE writes an event to the stream and flushes it to the client. It
+// reports whether the client is still connected.
+func writeSSE(c *app.RequestContext, event []byte) bool {
+	c.Write(event)
+	return c.Flush() == nil
+}
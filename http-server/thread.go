@@ -0,0 +1,46 @@
+package main
+
+import (
+	"context"
+
+	"github.com/TikTokTechImmersion/assignment_demo_2023/http-server/kitex_gen/rpc"
+	"github.com/TikTokTechImmersion/assignment_demo_2023/http-server/proto_gen/api"
+	"github.com/cloudwego/hertz/pkg/app"
+	"github.com/cloudwego/hertz/pkg/protocol/consts"
+)
+
+func pullThread(ctx context.Context, c *app.RequestContext) {
+	var req api.PullThreadRequest
+	err := c.Bind(&req)
+	if err != nil {
+		writeError(c, rpc.ErrorCode_INVALID_ARGUMENT, "Failed to parse request body: %v", err)
+		return
+	}
+	if !asCaller(c, &req.User) {
+		return
+	}
+	resp, err := cli.PullThread(ctx, &rpc.PullThreadRequest{
+		Chat:   req.Chat,
+		RootID: req.RootId,
+		Cursor: req.Cursor,
+		Limit:  req.Limit,
+		User:   req.User,
+	})
+	if err != nil {
+		writeCallError(c, err)
+		return
+	} else if resp.Code != 0 {
+		writeRPCError(c, resp.Code, resp.Msg)
+		return
+	}
+	messages := make([]*api.Message, 0, len(resp.Messages))
+	for _, msg := range resp.Messages {
+		messages = append(messages, newAPIMessage(msg))
+	}
+	c.JSON(consts.StatusOK, &api.PullThreadResponse{
+		Root:       newAPIMessage(resp.Root),
+		Messages:   messages,
+		HasMore:    resp.GetHasMore(),
+		NextCursor: resp.GetNextCursor(),
+	})
+}
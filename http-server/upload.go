@@ -0,0 +1,240 @@
+package main
+
+import (
+	"bufio"
+	"context"
+	"crypto/rand"
+	"errors"
+	"fmt"
+	"io"
+	"mime"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/TikTokTechImmersion/assignment_demo_2023/http-server/kitex_gen/rpc"
+	"github.com/TikTokTechImmersion/assignment_demo_2023/http-server/proto_gen/api"
+	"github.com/cloudwego/hertz/pkg/app"
+	"github.com/cloudwego/hertz/pkg/common/hlog"
+	"github.com/cloudwego/hertz/pkg/protocol/consts"
+)
+
+var (
+	blobs  BlobStore
+	signer *blobSigner
+)
+
+const (
+	// blobURLTTL is the lifetime of the signed download URLs of blobs.
+	blobURLTTL = 15 * time.Minute
+	// maxAttachmentNameLen bounds the length of the file names of uploads,
+	// as the rpc-server does.
+	maxAttachmentNameLen = 255
+	// maxBodySize bounds the bodies of the requests other than uploads.
+	maxBodySize = 4 << 20
+	// blobReapInterval is how often the blobs attached to no message anymore
+	// are deleted.
+	blobReapInterval = time.Minute
+	// blobReapBatch is the number of released blobs asked for at once.
+	blobReapBatch = 100
+)
+
+// newBlobSigner returns a blobSigner signing with secret, or with a random
+// secret if empty.
+func newBlobSigner(secret string) (*blobSigner, error) {
+	if secret == "" {
+		hlog.Warnf("BLOB_SIGNING_KEY is not set, download URLs are only valid on this http-server until it restarts")
+		b := make([]byte, 32)
+		if _, err := rand.Read(b); err != nil {
+			return nil, err
+		}
+		return &blobSigner{secret: b, ttl: blobURLTTL}, nil
+	}
+	return &blobSigner{secret: []byte(secret), ttl: blobURLTTL}, nil
+}
+
+// limitBody reads the bodies of the requests other than uploads in full,
+// up to maxBodySize bytes. The server streams request bodies for the sake of
+// uploads, so they are not bounded by Hertz anymore.
+func limitBody(ctx context.Context, c *app.RequestContext) {
+	if c.FullPath() == "/api/upload" {
+		c.Next(ctx)
+		return
+	}
+	body, err := io.ReadAll(io.LimitReader(c.RequestBodyStream(), maxBodySize+1))
+	if err != nil {
+		abortError(c, rpc.ErrorCode_INVALID_ARGUMENT, "Failed to read request body: %v", err)
+		return
+	} else if len(body) > maxBodySize {
+		c.SetConnectionClose()
+		abortError(c, rpc.ErrorCode_PAYLOAD_TOO_LARGE, "Request body is larger than %d bytes", maxBodySize)
+		return
+	}
+	c.Request.SetBodyRaw(body)
+	c.Next(ctx)
+}
+
+func upload(ctx context.Context, c *app.RequestContext) {
+	name := c.Query("name")
+	if len(name) > maxAttachmentNameLen {
+		writeError(c, rpc.ErrorCode_INVALID_ARGUMENT, "name is longer than %d bytes", maxAttachmentNameLen)
+		return
+	}
+	key, err := newBlobKey()
+	if err != nil {
+		writeError(c, rpc.ErrorCode_INTERNAL, err.Error())
+		return
+	}
+	// The blob is registered before it is stored, so that it is deleted
+	// even if no message attaches it.
+	reg, err := cli.RegisterBlob(ctx, &rpc.RegisterBlobRequest{Key: key})
+	if err != nil {
+		writeCallError(c, err)
+		return
+	} else if reg.Code != 0 {
+		writeRPCError(c, reg.Code, reg.Msg)
+		return
+	}
+	body := bufio.NewReader(c.RequestBodyStream())
+	mimeType := string(c.ContentType())
+	if t, _, _ := mime.ParseMediaType(mimeType); t == "" || t == "application/octet-stream" ||
+		t == "application/x-www-form-urlencoded" {
+		// Clients such as curl send a default type, the actual one is
+		// detected from the first bytes of the content.
+		head, _ := body.Peek(512)
+		mimeType = http.DetectContentType(head)
+	}
+	info, err := blobs.Put(ctx, key, body, BlobInfo{Name: name, MimeType: mimeType, Owner: c.GetString(callerKey)})
+	if errors.Is(err, errBlobTooLarge) {
+		c.SetConnectionClose()
+		writeError(c, rpc.ErrorCode_PAYLOAD_TOO_LARGE, err.Error())
+		return
+	} else if err != nil {
+		writeError(c, rpc.ErrorCode_UNAVAILABLE, err.Error())
+		return
+	}
+	c.JSON(consts.StatusOK, &api.UploadResponse{Attachment: newAPIAttachment(newRPCAttachment(key, info))})
+}
+
+func downloadBlob(ctx context.Context, c *app.RequestContext) {
+	key := c.Param("key")
+	if err := signer.verify(key, c.Query("expires"), c.Query("sig")); err != nil {
+		writeError(c, rpc.ErrorCode_PERMISSION_DENIED, err.Error())
+		return
+	}
+	body, info, err := blobs.Open(ctx, key)
+	if errors.Is(err, errBlobNotFound) {
+		writeError(c, rpc.ErrorCode_NOT_FOUND, err.Error())
+		return
+	} else if err != nil {
+		writeError(c, rpc.ErrorCode_UNAVAILABLE, err.Error())
+		return
+	}
+	// Only images are displayed inline, other content could run scripts in
+	// the origin of the server.
+	disposition := "attachment"
+	if strings.HasPrefix(info.MimeType, "image/") && info.MimeType != "image/svg+xml" {
+		disposition = "inline"
+	}
+	if info.Name != "" {
+		disposition = mime.FormatMediaType(disposition, map[string]string{"filename": info.Name})
+	}
+	c.Header("Content-Disposition", disposition)
+	c.Header("X-Content-Type-Options", "nosniff")
+	c.Header("Cache-Control", "private, max-age="+strconv.Itoa(int(blobURLTTL.Seconds())))
+	c.Header("ETag", strconv.Quote(info.Checksum))
+	c.SetContentType(info.MimeType)
+	c.SetBodyStream(body, int(info.Size))
+}
+
+// sentAttachment returns the attachment of a message sent by sender with the
+// blob stored under key, nil if key is empty. Its metadata is read from the
+// blob store, so that clients cannot forge it, and only the uploader of a
+// blob can attach it.
+func sentAttachment(ctx context.Context, key, sender string) (*rpc.Attachment, rpc.ErrorCode, error) {
+	if key == "" {
+		return nil, rpc.ErrorCode_OK, nil
+	}
+	info, err := blobs.Stat(ctx, key)
+	if errors.Is(err, errBlobNotFound) {
+		return nil, rpc.ErrorCode_INVALID_ARGUMENT, fmt.Errorf("unknown attachment %q", key)
+	} else if err != nil {
+		return nil, rpc.ErrorCode_UNAVAILABLE, err
+	}
+	if info.Owner != sender {
+		return nil, rpc.ErrorCode_PERMISSION_DENIED, fmt.Errorf("attachment %q was not uploaded by %q", key, sender)
+	}
+	return newRPCAttachment(key, info), rpc.ErrorCode_OK, nil
+}
+
+func newRPCAttachment(key string, info BlobInfo) *rpc.Attachment {
+	return &rpc.Attachment{
+		Key:      key,
+		Name:     info.Name,
+		MimeType: info.MimeType,
+		Size:     info.Size,
+		Checksum: info.Checksum,
+	}
+}
+
+// newAPIAttachment converts an attachment of the rpc-server to its HTTP API
+// form, with a signed download URL.
+func newAPIAttachment(a *rpc.Attachment) *api.Attachment {
+	if a == nil {
+		return nil
+	}
+	return &api.Attachment{
+		Key:      a.Key,
+		Name:     a.Name,
+		MimeType: a.MimeType,
+		Size:     a.Size,
+		Checksum: a.Checksum,
+		Url:      signer.url(a.Key),
+	}
+}
+
+// reapBlobs deletes the blobs released by the rpc-server, those attached to
+// no message anymore or never attached, every interval until ctx is done.
+// The rpc-server keeps the cursor of consumer, so that it resumes after
+// restarts.
+func reapBlobs(ctx context.Context, consumer string, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	var cursor int64
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			cursor = reapReleasedBlobs(ctx, consumer, cursor)
+		}
+	}
+}
+
+// reapReleasedBlobs deletes the blobs released by the rpc-server from cursor
+// on, the cursor of consumer if 0, and returns the cursor following the last
+// blob deleted. Failures are logged, and the blobs are deleted again from the
+// returned cursor on by the next run.
+func reapReleasedBlobs(ctx context.Context, consumer string, cursor int64) int64 {
+	for {
+		resp, err := cli.ReleasedBlobs(ctx, &rpc.ReleasedBlobsRequest{Cursor: cursor, Limit: blobReapBatch, Consumer: consumer})
+		if err == nil && resp.Code != 0 {
+			err = errors.New(resp.Msg)
+		}
+		if err != nil {
+			hlog.CtxErrorf(ctx, "failed to list released blobs: %v", err)
+			return cursor
+		}
+		if len(resp.Keys) == 0 {
+			return resp.GetNextCursor()
+		}
+		for _, key := range resp.Keys {
+			if err := blobs.Delete(ctx, key); err != nil {
+				hlog.CtxErrorf(ctx, "failed to delete blob %q: %v", key, err)
+				return cursor
+			}
+		}
+		cursor = resp.GetNextCursor()
+	}
+}
@@ -0,0 +1,135 @@
+package main
+
+import (
+	"context"
+	"time"
+
+	"github.com/TikTokTechImmersion/assignment_demo_2023/http-server/kitex_gen/rpc"
+	"github.com/TikTokTechImmersion/assignment_demo_2023/http-server/proto_gen/api"
+	"github.com/cloudwego/hertz/pkg/app"
+	"github.com/cloudwego/hertz/pkg/common/hlog"
+	"github.com/cloudwego/kitex/client/callopt"
+	"github.com/hertz-contrib/websocket"
+)
+
+const (
+	// subscribeWait is how long a Subscribe call waits for new messages.
+	subscribeWait = 25 * time.Second
+	// wsWriteTimeout bounds every write to a WebSocket client.
+	wsWriteTimeout = 10 * time.Second
+)
+
+var upgrader = websocket.HertzUpgrader{}
+
+// subscribeWS pushes messages to a WebSocket client. The client first sends an
+// api.SubscribeRequest, then receives every new api.Message of the chats as a
+// JSON text frame. Messages are pulled from the rpc-server with long polling
+// Subscribe calls.
+func subscribeWS(_ context.Context, c *app.RequestContext) {
+	caller := c.GetString(callerKey)
+	err := upgrader.Upgrade(c, func(conn *websocket.Conn) {
+		defer conn.Close()
+		var req api.SubscribeRequest
+		if err := conn.ReadJSON(&req); err != nil {
+			closeWS(conn, websocket.CloseUnsupportedData, "Failed to parse subscribe request: "+err.Error())
+			return
+		}
+		if err := actAs(caller, &req.User); err != nil {
+			closeWS(conn, websocket.ClosePolicyViolation, err.Error())
+			return
+		}
+
+		// Stop subscribing once the client goes away. Clients are not expected
+		// to send anything after the subscribe request.
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		go func() {
+			defer cancel()
+			for {
+				if _, _, err := conn.NextReader(); err != nil {
+					return
+				}
+			}
+		}()
+
+		cursors := make([]*rpc.ChatCursor, 0, len(req.Chats))
+		for _, chat := range req.Chats {
+			cursors = append(cursors, &rpc.ChatCursor{Chat: chat.Chat, Cursor: chat.Cursor})
+		}
+		waitMs := int32(subscribeWait / time.Millisecond)
+		for {
+			resp, err := cli.Subscribe(ctx, &rpc.SubscribeRequest{
+				User:   req.User,
+				Chats:  cursors,
+				WaitMs: &waitMs,
+			}, callopt.WithRPCTimeout(rpcTimeout+subscribeWait))
+			if ctx.Err() != nil {
+				return
+			} else if err != nil {
+				closeWS(conn, websocket.CloseInternalServerErr, err.Error())
+				return
+			} else if resp.Code != 0 {
+				closeWS(conn, websocket.ClosePolicyViolation, resp.Msg)
+				return
+			}
+			for _, msg := range resp.Messages {
+				conn.SetWriteDeadline(time.Now().Add(wsWriteTimeout))
+				if err := conn.WriteJSON(newAPIMessage(msg)); err != nil {
+					return
+				}
+			}
+			if len(resp.Messages) == 0 {
+				// Keep idle connections from being dropped by proxies.
+				err := conn.WriteControl(websocket.PingMessage, nil, time.Now().Add(wsWriteTimeout))
+				if err != nil {
+					return
+				}
+			}
+			cursors = resp.Cursors
+		}
+	})
+	if err != nil {
+		hlog.Warnf("WebSocket upgrade failed: %v", err)
+	}
+}
+
+// closeWS sends a close frame with code and reason to the client.
+func closeWS(conn *websocket.Conn, code int, reason string) {
+	// Control frames carry at most 125 bytes, 2 of which hold the code.
+	if len(reason) > 123 {
+		reason = reason[:123]
+	}
+	msg := websocket.FormatCloseMessage(code, reason)
+	conn.WriteControl(websocket.CloseMessage, msg, time.Now().Add(wsWriteTimeout))
+}
+
+// newAPIMessage converts a message of the rpc-server to its HTTP API form.
+func newAPIMessage(msg *rpc.Message) *api.Message {
+	return &api.Message{
+		Chat:       msg.Chat,
+		Text:       msg.Text,
+		Sender:     msg.Sender,
+		SendTime:   msg.SendTime,
+		Id:         msg.ID,
+		UpdateTime: msg.UpdateTime,
+		Edited:     msg.Edited,
+		Deleted:    msg.Deleted,
+		ReplyTo:    msg.ReplyTo,
+		RootId:     msg.RootID,
+		Reactions:  newAPIReactions(msg.Reactions),
+		Type:       api.ContentType(msg.Type),
+		Attachment: newAPIAttachment(msg.Attachment),
+		ExpireTime: msg.ExpireTime,
+	}
+}
+
+func newAPIReactions(reactions []*rpc.Reaction) []*api.Reaction {
+	if len(reactions) == 0 {
+		return nil
+	}
+	out := make([]*api.Reaction, 0, len(reactions))
+	for _, r := range reactions {
+		out = append(out, &api.Reaction{Emoji: r.Emoji, Count: r.Count, Users: r.Users})
+	}
+	return out
+}
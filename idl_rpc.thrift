@@ -1,38 +1,454 @@
 // API for pull mode IM service.
 namespace go rpc
 
+// ErrorCode catalogues the Code of every response. Codes equal the HTTP status
+// the http-server answers with.
+enum ErrorCode {
+    OK = 0
+    INVALID_ARGUMENT = 400  // the request is malformed or fails validation
+    UNAUTHENTICATED = 401   // the credentials or tokens are missing or invalid
+    PERMISSION_DENIED = 403 // the user cannot act on the chat, message or user
+    NOT_FOUND = 404         // the chat, message or blob does not exist
+    ALREADY_EXISTS = 409    // the chat or user exists already
+    DELETED = 410           // the message is deleted
+    PAYLOAD_TOO_LARGE = 413 // the request or upload is too large
+    RATE_LIMITED = 429      // too many requests, retry after RetryAfterMs
+    INTERNAL = 500          // unexpected failure, retrying does not help
+    UNAVAILABLE = 503       // a store or the rpc-server failed, retrying may help
+}
+
+struct Reaction {
+    1: string Emoji       // emoji the users reacted with
+    2: i32 Count          // number of users who reacted with Emoji
+    3: list<string> Users // users who reacted with Emoji, in the order they did
+}
+
+enum ContentType {
+    TEXT = 0  // text in Text
+    IMAGE = 1 // image in Attachment, captioned by Text
+    FILE = 2  // file in Attachment, captioned by Text
+}
+
+struct Attachment {
+    1: string Key      // key of the uploaded blob in the blob store of the http-server
+    2: string Name     // file name given by the uploader
+    3: string MimeType // MIME type of the content, "image/*" for IMAGE messages
+    4: i64 Size        // unit: bytes
+    5: string Checksum // hex encoded SHA-256 digest of the content
+}
+
 struct Message {
-    1: string Chat   // format "<member1>:<member2>", e.g. "john:doe"
-    2: string Text   // message text content
-    3: string Sender // sender identifier
-    4: i64 SendTime  // unit: microseconds
+    1: string Chat                     // format "<member1>:<member2>", e.g. "john:doe", or a group chat ID
+    2: string Text                     // message text content, empty once deleted
+    3: string Sender                   // sender identifier
+    4: i64 SendTime                    // unit: microseconds, assigned by the server
+    5: i64 ID                          // assigned by the server, increases monotonically within the chat
+    6: i64 UpdateTime                  // unit: microseconds, time of the last change of the message, unique within the chat
+    7: bool Edited                     // whether the text was edited since the message was sent
+    8: bool Deleted                    // whether the message was deleted, leaving a tombstone
+    9: i64 ReplyTo                     // ID of the message of the same chat replied to or quoted, 0 if none
+    10: i64 RootID                     // assigned by the server, ID of the first message of the thread of a reply, 0 if not a reply
+    11: list<Reaction> Reactions       // assigned by the server, reactions to the message in the order they were first added
+    12: ContentType Type               // kind of content of the message, TEXT by default
+    13: optional Attachment Attachment // content of IMAGE and FILE messages, removed once deleted
+    14: i64 ExpireTime                 // unit: microseconds, assigned by the server, time the message disappears at, 0 if never
 }
 
 struct SendRequest {
-    1: required Message message // message to be sent
+    1: required Message message       // message to be sent
+    2: optional string IdempotencyKey // if set, retries with the same key within 10 minutes return the original message instead of sending it again
 }
 
 struct SendResponse {
-    1: required i32 Code   // zero for success, non-zero for failures
-    2: required string Msg // prompt information
+    1: required i32 Code     // zero for success, an ErrorCode for failures
+    2: required string Msg   // prompt information
+    3: optional i64 ID       // ID assigned to the sent message
+    4: optional i64 SendTime // send time assigned to the sent message
+    5: optional i64 RetryAfterMs // if Code is RATE_LIMITED, how long until the sender or chat may send again
 }
 
 struct PullRequest {
-    1: required string Chat  // format "<member1>:<member2>", e.g. "john:doe"
+    1: required string Chat  // format "<member1>:<member2>", e.g. "john:doe", or a group chat ID
     2: required i64 Cursor   // starting position of message's send_time, inclusively, 0 by default
     3: required i32 Limit    // the maximum number of messages returned per request, 10 by default
     4: optional bool Reverse // if false, the results will be sorted in ascending order by time
+    5: optional string User  // member of the chat pulling the messages
+    6: optional i32 WaitMs   // if positive and no message is available yet, wait up to WaitMs milliseconds for one
+    7: optional bool Changes // if true, pull the messages sent or changed from Cursor on in ascending order by UpdateTime, cursors being update times
 }
 
 struct PullResponse {
-    1: required i32 Code   // zero for success, non-zero for failures
+    1: required i32 Code   // zero for success, an ErrorCode for failures
     2: required string Msg // prompt information
     3: optional list<Message> Messages
     4: optional bool HasMore   // if true, can use next_cursor to pull the next page of messages
     5: optional i64 NextCursor // starting position of next page, inclusively
+    6: optional list<ReadPosition> ReadPositions // last message read by each member of the chat
+    7: optional i64 RetryAfterMs // if Code is RATE_LIMITED, how long until the user or chat may be pulled again
+}
+
+struct ReadPosition {
+    1: required string User   // member of the chat
+    2: required i64 MessageID // ID of the last message read by User, 0 if none
+}
+
+struct CreateChatRequest {
+    1: required string Creator       // first member of the group chat
+    2: required list<string> Members // other members of the group chat
+}
+
+struct CreateChatResponse {
+    1: required i32 Code    // zero for success, an ErrorCode for failures
+    2: required string Msg  // prompt information
+    3: optional string Chat // ID of the created group chat
+}
+
+struct AddMembersRequest {
+    1: required string Chat          // group chat ID
+    2: required string Operator      // member of the chat adding the others
+    3: required list<string> Members // users to add to the chat
+}
+
+struct AddMembersResponse {
+    1: required i32 Code   // zero for success, an ErrorCode for failures
+    2: required string Msg // prompt information
+}
+
+struct RemoveMembersRequest {
+    1: required string Chat          // group chat ID
+    2: required string Operator      // member of the chat removing the others
+    3: required list<string> Members // members to remove from the chat
+}
+
+struct RemoveMembersResponse {
+    1: required i32 Code   // zero for success, an ErrorCode for failures
+    2: required string Msg // prompt information
+}
+
+struct ListMembersRequest {
+    1: required string Chat     // format "<member1>:<member2>", e.g. "john:doe", or a group chat ID
+    2: required string Operator // member of the chat listing the members
+}
+
+struct ListMembersResponse {
+    1: required i32 Code   // zero for success, an ErrorCode for failures
+    2: required string Msg // prompt information
+    3: optional list<string> Members
+}
+
+struct ChatCursor {
+    1: required string Chat // format "<member1>:<member2>", e.g. "john:doe", or a group chat ID
+    2: required i64 Cursor  // starting position of message's send_time, inclusively
+    3: optional i64 AfterID // if positive, start after the message with this ID instead of at Cursor
+}
+
+struct SubscribeRequest {
+    1: required string User            // member of the chats pulling the messages
+    2: required list<ChatCursor> Chats // chats to pull messages from, and where to start in each of them
+    3: optional i32 Limit              // the maximum number of messages returned per chat, 10 by default
+    4: optional i32 WaitMs             // if positive and no message is available yet, wait up to WaitMs milliseconds for one
+}
+
+struct SubscribeResponse {
+    1: required i32 Code   // zero for success, an ErrorCode for failures
+    2: required string Msg // prompt information
+    3: optional list<Message> Messages   // sorted in ascending order by time within each chat
+    4: optional list<ChatCursor> Cursors // where to start in each chat with the next Subscribe
+}
+
+struct BatchSendRequest {
+    1: required list<SendRequest> Requests // messages to be sent, at most 1000
+}
+
+struct BatchSendResponse {
+    1: required i32 Code   // zero for success, an ErrorCode for failures
+    2: required string Msg // prompt information
+    3: optional list<SendResponse> Responses // result of every request, in order
+}
+
+struct MultiPullRequest {
+    1: required string User            // member of the chats pulling the messages
+    2: required list<ChatCursor> Chats // chats to pull messages from, and where to start in each of them, at most 1000
+    3: optional i32 Limit              // the maximum number of messages returned per chat, 10 by default
+    4: optional bool Reverse           // if false, the results will be sorted in ascending order by time
+}
+
+struct MultiPullResponse {
+    1: required i32 Code   // zero for success, an ErrorCode for failures
+    2: required string Msg // prompt information
+    3: optional list<PullResponse> Responses // result of every chat, in order
+    4: optional i64 RetryAfterMs // if Code is RATE_LIMITED, how long until the user may pull again
+}
+
+struct ChatSummary {
+    1: required string Chat     // format "<member1>:<member2>", e.g. "john:doe", or a group chat ID
+    2: required i64 ActiveTime  // unit: microseconds, time of the last message of the chat or of joining it, unique per user
+    3: optional Message LastMessage
+    4: required i64 UnreadCount // number of messages of the chat the user did not send nor read
+}
+
+struct ListChatsRequest {
+    1: required string User // user whose chats are listed
+    2: required i64 Cursor  // starting position of chats' ActiveTime, inclusively, 0 to start from the latest chat
+    3: required i32 Limit   // the maximum number of chats returned per request, 10 by default
+}
+
+struct ListChatsResponse {
+    1: required i32 Code   // zero for success, an ErrorCode for failures
+    2: required string Msg // prompt information
+    3: optional list<ChatSummary> Chats // sorted in descending order by ActiveTime
+    4: optional bool HasMore   // if true, can use NextCursor to list the next page of chats
+    5: optional i64 NextCursor // starting position of next page, inclusively
+}
+
+struct MarkReadRequest {
+    1: required string Chat   // format "<member1>:<member2>", e.g. "john:doe", or a group chat ID
+    2: required string User   // member of the chat who read the message
+    3: required i64 MessageID // ID of the last message read by User, earlier positions are ignored
+}
+
+struct MarkReadResponse {
+    1: required i32 Code   // zero for success, an ErrorCode for failures
+    2: required string Msg // prompt information
+}
+
+struct EditMessageRequest {
+    1: required string Chat // format "<member1>:<member2>", e.g. "john:doe", or a group chat ID
+    2: required i64 ID      // ID of the message to edit
+    3: required string User // sender of the message
+    4: required string Text // new text of the message
+}
+
+struct EditMessageResponse {
+    1: required i32 Code   // zero for success, an ErrorCode for failures
+    2: required string Msg // prompt information
+    3: optional Message Message // edited message
+}
+
+struct DeleteMessageRequest {
+    1: required string Chat // format "<member1>:<member2>", e.g. "john:doe", or a group chat ID
+    2: required i64 ID      // ID of the message to delete
+    3: required string User // sender of the message
+}
+
+struct DeleteMessageResponse {
+    1: required i32 Code   // zero for success, an ErrorCode for failures
+    2: required string Msg // prompt information
+}
+
+struct MessageHistoryRequest {
+    1: required string Chat // format "<member1>:<member2>", e.g. "john:doe", or a group chat ID
+    2: required i64 ID      // ID of the message
+    3: required string User // member of the chat reading the history
+}
+
+struct MessageHistoryResponse {
+    1: required i32 Code   // zero for success, an ErrorCode for failures
+    2: required string Msg // prompt information
+    3: optional list<Message> Messages // versions of the message in ascending order by UpdateTime, the current one last
+}
+
+struct PullThreadRequest {
+    1: required string Chat // format "<member1>:<member2>", e.g. "john:doe", or a group chat ID
+    2: required i64 RootID  // ID of the first message of the thread
+    3: required i64 Cursor  // starting position of reply's send_time, inclusively, 0 by default
+    4: required i32 Limit   // the maximum number of replies returned per request, 10 by default
+    5: required string User // member of the chat pulling the thread
+}
+
+struct PullThreadResponse {
+    1: required i32 Code   // zero for success, an ErrorCode for failures
+    2: required string Msg // prompt information
+    3: optional Message Root // first message of the thread
+    4: optional list<Message> Messages // replies in ascending order by send time
+    5: optional bool HasMore   // if true, can use next_cursor to pull the next page of replies
+    6: optional i64 NextCursor // starting position of next page, inclusively
+}
+
+struct AddReactionRequest {
+    1: required string Chat  // format "<member1>:<member2>", e.g. "john:doe", or a group chat ID
+    2: required i64 ID       // ID of the message to react to
+    3: required string User  // member of the chat reacting
+    4: required string Emoji // emoji to react with
+}
+
+struct AddReactionResponse {
+    1: required i32 Code   // zero for success, an ErrorCode for failures
+    2: required string Msg // prompt information
+    3: optional Message Message // message with its updated reactions
+}
+
+struct RemoveReactionRequest {
+    1: required string Chat  // format "<member1>:<member2>", e.g. "john:doe", or a group chat ID
+    2: required i64 ID       // ID of the message reacted to
+    3: required string User  // member of the chat who reacted
+    4: required string Emoji // emoji to remove
+}
+
+struct RemoveReactionResponse {
+    1: required i32 Code   // zero for success, an ErrorCode for failures
+    2: required string Msg // prompt information
+    3: optional Message Message // message with its updated reactions
+}
+
+struct SearchRequest {
+    1: required string User  // user searching, in the chats they are a member of
+    2: optional string Chat  // if set, only search this chat
+    3: required string Query // words to search for, all of which must match
+    4: required i64 Cursor   // starting position of the results, inclusively, 0 for the latest message
+    5: required i32 Limit    // the maximum number of messages returned per request, 10 by default
+}
+
+struct SearchResponse {
+    1: required i32 Code   // zero for success, an ErrorCode for failures
+    2: required string Msg // prompt information
+    3: optional list<Message> Messages // matching messages in descending order by send time
+    4: optional bool HasMore   // if true, can use next_cursor to get the next page of results
+    5: optional i64 NextCursor // starting position of next page, inclusively
+}
+
+struct Retention {
+    1: i64 MaxAge         // unit: seconds, messages sent longer ago expire, 0 for no limit
+    2: i64 MaxCount       // messages whose IDs are not among the MaxCount latest ones expire, 0 for no limit
+    3: i64 DisappearAfter // unit: seconds, if positive, messages sent from now on expire this long after being sent
+}
+
+struct GetRetentionRequest {
+    1: required string Chat // format "<member1>:<member2>", e.g. "john:doe", or a group chat ID
+    2: required string User // member of the chat reading the policy
+}
+
+struct GetRetentionResponse {
+    1: required i32 Code            // zero for success, an ErrorCode for failures
+    2: required string Msg          // prompt information
+    3: optional Retention Retention // retention policy of the chat, all zero if none was set
+}
+
+struct SetRetentionRequest {
+    1: required string Chat         // format "<member1>:<member2>", e.g. "john:doe", or a group chat ID
+    2: required string User         // member of the chat setting the policy
+    3: required Retention Retention // new retention policy of the chat
+}
+
+struct SetRetentionResponse {
+    1: required i32 Code   // zero for success, an ErrorCode for failures
+    2: required string Msg // prompt information
+}
+
+struct RegisterBlobRequest {
+    1: required string Key // key of the blob just uploaded to the blob store of the http-server
+}
+
+struct RegisterBlobResponse {
+    1: required i32 Code   // zero for success, an ErrorCode for failures
+    2: required string Msg // prompt information
+}
+
+struct ReleasedBlobsRequest {
+    1: required i64 Cursor      // position of the first key returned, acknowledging the keys before it, 0 to resume from the last key acknowledged
+    2: required i32 Limit       // the maximum number of keys returned, 100 by default and 1000 at most
+    3: required string Consumer // name of the http-server deleting the blobs, keys are kept until every consumer acknowledged them
+}
+
+struct ReleasedBlobsResponse {
+    1: required i32 Code           // zero for success, an ErrorCode for failures
+    2: required string Msg         // prompt information
+    3: optional list<string> Keys  // keys of the blobs no message is attached to anymore, to be deleted
+    4: optional i64 NextCursor     // position following the keys returned
+}
+
+struct RegisterRequest {
+    1: required string User     // user name, also the ID of the user in chats
+    2: required string Password // between 8 and 72 bytes
+}
+
+struct RegisterResponse {
+    1: required i32 Code   // zero for success, an ErrorCode for failures
+    2: required string Msg // prompt information
+}
+
+struct Tokens {
+    1: string AccessToken  // JWT authenticating the user, as a bearer token
+    2: string RefreshToken // opaque token exchanged for new tokens once the access token expires
+    3: i64 ExpiresIn       // unit: seconds, lifetime of the access token
+}
+
+struct LoginRequest {
+    1: required string User     // registered user name
+    2: required string Password // password of the user
+}
+
+struct LoginResponse {
+    1: required i32 Code      // zero for success, an ErrorCode for failures
+    2: required string Msg    // prompt information
+    3: optional Tokens Tokens // tokens of a new session of the user
+}
+
+struct RefreshTokenRequest {
+    1: required string RefreshToken // latest refresh token of the session, which it replaces
+}
+
+struct RefreshTokenResponse {
+    1: required i32 Code      // zero for success, an ErrorCode for failures
+    2: required string Msg    // prompt information
+    3: optional Tokens Tokens // new tokens of the session
+}
+
+struct LogoutRequest {
+    1: required string RefreshToken // latest refresh token of the session to end
+}
+
+struct LogoutResponse {
+    1: required i32 Code   // zero for success, an ErrorCode for failures
+    2: required string Msg // prompt information
+}
+
+struct GetKeySetRequest {}
+
+struct GetKeySetResponse {
+    1: required i32 Code      // zero for success, an ErrorCode for failures
+    2: required string Msg    // prompt information
+    3: optional string KeySet // JSON Web Key Set verifying access tokens
+}
+
+struct RevokedSessionsRequest {}
+
+struct RevokedSessionsResponse {
+    1: required i32 Code               // zero for success, an ErrorCode for failures
+    2: required string Msg             // prompt information
+    3: optional list<string> Sessions  // IDs of the sessions revoked while their access tokens may still be valid
+}
+
+service AuthService {
+    RegisterResponse Register(1: RegisterRequest req)
+    LoginResponse Login(2: LoginRequest req)
+    RefreshTokenResponse RefreshToken(3: RefreshTokenRequest req)
+    LogoutResponse Logout(4: LogoutRequest req)
+    GetKeySetResponse GetKeySet(5: GetKeySetRequest req)
+    RevokedSessionsResponse RevokedSessions(6: RevokedSessionsRequest req) // sessions whose access tokens are rejected
 }
 
 service IMService {
     SendResponse Send(1: SendRequest req)
     PullResponse Pull(2: PullRequest req)
+    CreateChatResponse CreateChat(3: CreateChatRequest req)
+    AddMembersResponse AddMembers(4: AddMembersRequest req)
+    RemoveMembersResponse RemoveMembers(5: RemoveMembersRequest req)
+    ListMembersResponse ListMembers(6: ListMembersRequest req)
+    SubscribeResponse Subscribe(7: SubscribeRequest req) // long polling Pull over several chats
+    BatchSendResponse BatchSend(8: BatchSendRequest req)
+    MultiPullResponse MultiPull(9: MultiPullRequest req)
+    ListChatsResponse ListChats(10: ListChatsRequest req)
+    MarkReadResponse MarkRead(11: MarkReadRequest req)
+    EditMessageResponse EditMessage(12: EditMessageRequest req)
+    DeleteMessageResponse DeleteMessage(13: DeleteMessageRequest req)
+    MessageHistoryResponse MessageHistory(14: MessageHistoryRequest req)
+    PullThreadResponse PullThread(15: PullThreadRequest req)
+    AddReactionResponse AddReaction(16: AddReactionRequest req)
+    RemoveReactionResponse RemoveReaction(17: RemoveReactionRequest req)
+    SearchResponse Search(18: SearchRequest req)
+    GetRetentionResponse GetRetention(19: GetRetentionRequest req)
+    SetRetentionResponse SetRetention(20: SetRetentionRequest req)
+    ReleasedBlobsResponse ReleasedBlobs(21: ReleasedBlobsRequest req) // blobs of the http-server to be deleted
+    RegisterBlobResponse RegisterBlob(22: RegisterBlobRequest req)    // blobs of the http-server to be deleted unless attached in time
 }
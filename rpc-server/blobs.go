@@ -0,0 +1,55 @@
+package main
+
+import (
+	"context"
+	"time"
+
+	"github.com/TikTokTechImmersion/assignment_demo_2023/rpc-server/kitex_gen/rpc"
+)
+
+// BlobRefStore counts the messages attached to every blob uploaded to the
+// http-server, and queues the blobs no message is attached to anymore, so that
+// the http-servers delete them. Blobs may be attached to several messages, by
+// sending the same attachment again.
+type BlobRefStore interface {
+	// Register records the key of a blob just uploaded, attached to no
+	// message yet. Unless attached first, it is released by Expire once past
+	// deadline, unit: microseconds.
+	Register(ctx context.Context, key string, deadline int64) error
+	// Attach counts a message more for every key of keys.
+	Attach(ctx context.Context, keys ...string) error
+	// Detach counts a message less for every key of keys, and releases the
+	// keys attached to no message anymore. Keys never attached, such as
+	// those of the messages sent before the store was set up, are released
+	// too.
+	Detach(ctx context.Context, keys ...string) error
+	// Expire releases the registered keys past their deadline at now, unit:
+	// microseconds, and forgets the consumers which did not ask for released
+	// keys for blobConsumerTTL.
+	Expire(ctx context.Context, now int64) error
+	// Released returns up to limit keys released from the cursor of consumer
+	// on, in the order they were released, and the cursor following them.
+	// A cursor past the one of consumer acknowledges the keys before it and
+	// becomes the cursor of consumer, other cursors, such as 0, acknowledge
+	// nothing. Consumers never seen start at the first key kept. Keys are
+	// forgotten once acknowledged by every consumer. A non-positive limit
+	// returns every remaining key.
+	Released(ctx context.Context, consumer string, cursor int64, limit int) ([]string, int64, error)
+	// Close releases the resources held by the store.
+	Close() error
+}
+
+// blobConsumerTTL is how long the consumers of released keys are kept without
+// asking for them, so that removed http-servers do not hold keys back forever.
+const blobConsumerTTL = 7 * 24 * time.Hour
+
+// attachmentKeys returns the blob keys of the attachments of msgs.
+func attachmentKeys(msgs ...*rpc.Message) []string {
+	var keys []string
+	for _, msg := range msgs {
+		if msg.Attachment != nil {
+			keys = append(keys, msg.Attachment.Key)
+		}
+	}
+	return keys
+}
@@ -0,0 +1,247 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"sort"
+	"sync"
+	"time"
+)
+
+// blobRecord is a record of the blob journal, holding the keys registered,
+// attached to, detached from messages or expired, and the cursor up to which
+// a consumer acknowledged released keys. Once the journal is rewritten, its
+// single record holds the counts of the attached keys, the registered keys,
+// the keys released from First on and the consumers instead.
+type blobRecord struct {
+	Register  string                  `json:",omitempty"`
+	Deadline  int64                   `json:",omitempty"`
+	Attach    []string                `json:",omitempty"`
+	Detach    []string                `json:",omitempty"`
+	Expired   []string                `json:",omitempty"`
+	Forget    []string                `json:",omitempty"` // expired consumers
+	Consumer  string                  `json:",omitempty"`
+	Ack       int64                   `json:",omitempty"`
+	Time      int64                   `json:",omitempty"` // of the acknowledgment
+	Refs      map[string]int          `json:",omitempty"`
+	Pending   map[string]int64        `json:",omitempty"`
+	Released  []string                `json:",omitempty"`
+	First     int64                   `json:",omitempty"`
+	Consumers map[string]blobConsumer `json:",omitempty"`
+}
+
+// blobConsumer is the position of a consumer in the released keys.
+type blobConsumer struct {
+	Cursor int64 // of the first key not acknowledged
+	Time   int64 // of the last acknowledgment
+}
+
+// blobConsumerTouch is how often the time of consumers asking for released
+// keys is written to the journal, even if they acknowledged nothing.
+const blobConsumerTouch = time.Hour
+
+// fileBlobRefStore is a BlobRefStore held in memory, rebuilt on startup from
+// an append-only journal on local disk, which is rewritten once most of its
+// records are stale.
+type fileBlobRefStore struct {
+	mu        sync.Mutex
+	journal   *journal
+	records   int              // in the journal
+	refs      map[string]int   // messages attached by key
+	pending   map[string]int64 // deadlines of the registered keys by key
+	released  []string         // keys released and not acknowledged by every consumer yet
+	first     int64            // cursor of released[0]
+	consumers map[string]blobConsumer
+}
+
+// OpenFileBlobRefStore opens the blob journal at path, creating it if needed.
+func OpenFileBlobRefStore(path string) (BlobRefStore, error) {
+	s := &fileBlobRefStore{
+		refs:      make(map[string]int),
+		pending:   make(map[string]int64),
+		consumers: make(map[string]blobConsumer),
+	}
+	j, err := openJournal(path, func(line []byte) error {
+		var rec blobRecord
+		if err := json.Unmarshal(line, &rec); err != nil {
+			return err
+		}
+		s.apply(&rec)
+		s.records++
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	s.journal = j
+	return s, nil
+}
+
+// apply applies rec.
+func (s *fileBlobRefStore) apply(rec *blobRecord) {
+	if rec.Refs != nil || rec.Pending != nil || rec.Released != nil || rec.First != 0 || rec.Consumers != nil {
+		s.refs, s.pending, s.released, s.first, s.consumers = rec.Refs, rec.Pending, rec.Released, rec.First, rec.Consumers
+		if s.refs == nil {
+			s.refs = make(map[string]int)
+		}
+		if s.pending == nil {
+			s.pending = make(map[string]int64)
+		}
+		if s.consumers == nil {
+			s.consumers = make(map[string]blobConsumer)
+		}
+	}
+	if rec.Register != "" {
+		s.pending[rec.Register] = rec.Deadline
+	}
+	for _, key := range rec.Attach {
+		delete(s.pending, key)
+		s.refs[key]++
+	}
+	for _, key := range rec.Detach {
+		if s.refs[key] > 1 {
+			s.refs[key]--
+			continue
+		}
+		delete(s.refs, key)
+		s.released = append(s.released, key)
+	}
+	for _, key := range rec.Expired {
+		delete(s.pending, key)
+		s.released = append(s.released, key)
+	}
+	for _, consumer := range rec.Forget {
+		delete(s.consumers, consumer)
+	}
+	if rec.Consumer != "" {
+		c := s.consumers[rec.Consumer]
+		if rec.Ack > c.Cursor {
+			c.Cursor = rec.Ack
+		}
+		c.Time = rec.Time
+		s.consumers[rec.Consumer] = c
+	}
+	s.trim()
+}
+
+// trim forgets the released keys acknowledged by every consumer.
+func (s *fileBlobRefStore) trim() {
+	if len(s.consumers) == 0 {
+		return
+	}
+	last := s.first + int64(len(s.released))
+	for _, c := range s.consumers {
+		if c.Cursor < last {
+			last = c.Cursor
+		}
+	}
+	if n := last - s.first; n > 0 {
+		s.released = s.released[n:]
+		s.first += n
+	}
+}
+
+func (s *fileBlobRefStore) Register(ctx context.Context, key string, deadline int64) error {
+	return s.append(&blobRecord{Register: key, Deadline: deadline})
+}
+
+func (s *fileBlobRefStore) Attach(ctx context.Context, keys ...string) error {
+	if len(keys) == 0 {
+		return nil
+	}
+	return s.append(&blobRecord{Attach: keys})
+}
+
+func (s *fileBlobRefStore) Detach(ctx context.Context, keys ...string) error {
+	if len(keys) == 0 {
+		return nil
+	}
+	return s.append(&blobRecord{Detach: keys})
+}
+
+func (s *fileBlobRefStore) Expire(ctx context.Context, now int64) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	rec := &blobRecord{}
+	for key, deadline := range s.pending {
+		if deadline <= now {
+			rec.Expired = append(rec.Expired, key)
+		}
+	}
+	// Keys are released in the order of their deadlines.
+	sort.Slice(rec.Expired, func(i, j int) bool {
+		a, b := rec.Expired[i], rec.Expired[j]
+		return s.pending[a] < s.pending[b] || s.pending[a] == s.pending[b] && a < b
+	})
+	for consumer, c := range s.consumers {
+		if c.Time <= now-blobConsumerTTL.Microseconds() {
+			rec.Forget = append(rec.Forget, consumer)
+		}
+	}
+	if len(rec.Expired) == 0 && len(rec.Forget) == 0 {
+		return nil
+	}
+	return s.appendLocked(rec)
+}
+
+func (s *fileBlobRefStore) Released(ctx context.Context, consumer string, cursor int64, limit int) ([]string, int64, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	c, ok := s.consumers[consumer]
+	if !ok {
+		c.Cursor = s.first
+	}
+	// Cursors past the released keys were not returned by this store, they
+	// acknowledge nothing.
+	if cursor > c.Cursor && cursor <= s.first+int64(len(s.released)) {
+		c.Cursor = cursor
+	}
+	now := time.Now().UnixMicro()
+	if !ok || c.Cursor > s.consumers[consumer].Cursor || now-c.Time >= blobConsumerTouch.Microseconds() {
+		if err := s.appendLocked(&blobRecord{Consumer: consumer, Ack: c.Cursor, Time: now}); err != nil {
+			return nil, 0, err
+		}
+	}
+	keys := s.released[c.Cursor-s.first:]
+	if limit > 0 && len(keys) > limit {
+		keys = keys[:limit]
+	}
+	return append([]string(nil), keys...), c.Cursor + int64(len(keys)), nil
+}
+
+// append writes rec to the journal and applies it.
+func (s *fileBlobRefStore) append(rec *blobRecord) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.appendLocked(rec)
+}
+
+func (s *fileBlobRefStore) appendLocked(rec *blobRecord) error {
+	if err := s.journal.append(rec); err != nil {
+		return err
+	}
+	s.records++
+	s.apply(rec)
+	return s.compact()
+}
+
+// compact rewrites the journal with a single record, once most of its
+// records are stale.
+func (s *fileBlobRefStore) compact() error {
+	live := len(s.refs) + len(s.pending) + len(s.released) + len(s.consumers)
+	if stale := s.records - 1; stale < fileRewriteMin || stale < live {
+		return nil
+	}
+	rec := &blobRecord{Refs: s.refs, Pending: s.pending, Released: s.released, First: s.first, Consumers: s.consumers}
+	if err := s.journal.rewrite(rec); err != nil {
+		return err
+	}
+	s.records = 1
+	return nil
+}
+
+func (s *fileBlobRefStore) Close() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.journal.Close()
+}
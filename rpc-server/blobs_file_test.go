@@ -0,0 +1,180 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFileBlobRefStore(t *testing.T) {
+	ctx := context.Background()
+	path := filepath.Join(t.TempDir(), "blobs.log")
+	store, err := OpenFileBlobRefStore(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	released := func(cursor int64, limit int) ([]string, int64) {
+		keys, next, err := store.Released(ctx, "h1", cursor, limit)
+		assert.NoError(t, err)
+		return keys, next
+	}
+	assert.NoError(t, store.Attach(ctx, "a", "b", "a"))
+	assert.NoError(t, store.Detach(ctx, "a", "b"))
+	keys, next := released(0, 0)
+	assert.Equal(t, []string{"b"}, keys)
+	assert.Equal(t, int64(1), next)
+	// Keys attached to a message are released along with its last message,
+	// and keys never attached at once.
+	assert.NoError(t, store.Detach(ctx, "a", "c"))
+	keys, next = released(0, 2)
+	assert.Equal(t, []string{"b", "a"}, keys)
+	assert.Equal(t, int64(2), next)
+	assert.NoError(t, store.Close())
+
+	// Released keys are kept until acknowledged by a later cursor.
+	store, err = OpenFileBlobRefStore(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	keys, next = released(1, 0)
+	assert.Equal(t, []string{"a", "c"}, keys)
+	assert.Equal(t, int64(3), next)
+	keys, next = released(0, 0)
+	assert.Equal(t, []string{"a", "c"}, keys)
+	keys, next = released(next, 0)
+	assert.Empty(t, keys)
+	assert.Equal(t, int64(3), next)
+	assert.NoError(t, store.Close())
+
+	store, err = OpenFileBlobRefStore(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer store.Close()
+	keys, next = released(0, 0)
+	assert.Empty(t, keys)
+	assert.Equal(t, int64(3), next)
+	// Cursors past the released keys acknowledge none of them.
+	assert.NoError(t, store.Detach(ctx, "d"))
+	keys, next = released(10, 0)
+	assert.Equal(t, []string{"d"}, keys)
+	assert.Equal(t, int64(4), next)
+	keys, _ = released(3, 0)
+	assert.Equal(t, []string{"d"}, keys)
+}
+
+func TestFileBlobRefStore_Consumers(t *testing.T) {
+	ctx := context.Background()
+	path := filepath.Join(t.TempDir(), "blobs.log")
+	store, err := OpenFileBlobRefStore(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	released := func(consumer string, cursor int64) []string {
+		keys, _, err := store.Released(ctx, consumer, cursor, 0)
+		assert.NoError(t, err)
+		return keys
+	}
+	assert.NoError(t, store.Detach(ctx, "a", "b"))
+	assert.Equal(t, []string{"a", "b"}, released("h1", 0))
+	assert.Equal(t, []string{"a", "b"}, released("h2", 0))
+	// Keys are kept until every consumer acknowledged them.
+	assert.Empty(t, released("h1", 2))
+	assert.Equal(t, []string{"b"}, released("h2", 1))
+	assert.NoError(t, store.Close())
+
+	// Consumers resume from their own cursor.
+	store, err = OpenFileBlobRefStore(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer store.Close()
+	assert.Empty(t, released("h1", 0))
+	assert.Equal(t, []string{"b"}, released("h2", 0))
+	// Consumers never seen start at the first key kept.
+	assert.Equal(t, []string{"b"}, released("h3", 0))
+
+	// Consumers gone for too long no longer hold keys back.
+	now := time.Now().Add(blobConsumerTTL).UnixMicro()
+	assert.NoError(t, store.Expire(ctx, now))
+	assert.NoError(t, store.Detach(ctx, "c"))
+	assert.Equal(t, []string{"c"}, released("h1", 2))
+	assert.Empty(t, released("h1", 3))
+}
+
+func TestFileBlobRefStore_Expire(t *testing.T) {
+	ctx := context.Background()
+	path := filepath.Join(t.TempDir(), "blobs.log")
+	store, err := OpenFileBlobRefStore(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.NoError(t, store.Register(ctx, "late", 20))
+	assert.NoError(t, store.Register(ctx, "early", 10))
+	assert.NoError(t, store.Register(ctx, "sent", 10))
+	assert.NoError(t, store.Register(ctx, "pending", 30))
+	assert.NoError(t, store.Attach(ctx, "sent"))
+	assert.NoError(t, store.Expire(ctx, 5))
+	keys, _, err := store.Released(ctx, "h1", 0, 0)
+	assert.NoError(t, err)
+	assert.Empty(t, keys)
+	assert.NoError(t, store.Close())
+
+	// Registered keys attached to no message by their deadline are
+	// released, in the order of their deadlines.
+	store, err = OpenFileBlobRefStore(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer store.Close()
+	assert.NoError(t, store.Expire(ctx, 20))
+	keys, _, err = store.Released(ctx, "h1", 0, 0)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"early", "late"}, keys)
+	assert.NoError(t, store.Detach(ctx, "sent"))
+	keys, _, err = store.Released(ctx, "h1", 2, 0)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"sent"}, keys)
+}
+
+func TestFileBlobRefStore_Rewrite(t *testing.T) {
+	ctx := context.Background()
+	path := filepath.Join(t.TempDir(), "blobs.log")
+	store, err := OpenFileBlobRefStore(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.NoError(t, store.Attach(ctx, "kept", "kept"))
+	for i := 0; i < fileRewriteMin; i++ {
+		key := fmt.Sprint(i)
+		assert.NoError(t, store.Attach(ctx, key))
+		assert.NoError(t, store.Detach(ctx, key))
+	}
+	assert.NoError(t, store.Detach(ctx, "kept"))
+	_, next, err := store.Released(ctx, "h1", 0, 0)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(fileRewriteMin), next)
+	_, _, err = store.Released(ctx, "h1", next, 0)
+	assert.NoError(t, err)
+	assert.NoError(t, store.Close())
+	b, err := os.ReadFile(path)
+	assert.NoError(t, err)
+	assert.Less(t, bytes.Count(b, []byte("\n")), 10)
+
+	store, err = OpenFileBlobRefStore(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer store.Close()
+	assert.NoError(t, store.Detach(ctx, "kept"))
+	keys, next, err := store.Released(ctx, "h1", 0, 0)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"kept"}, keys)
+	assert.Equal(t, int64(fileRewriteMin+1), next)
+}
@@ -0,0 +1,79 @@
+package main
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strings"
+)
+
+var errInvalidChat = errors.New("invalid chat")
+
+// chatID identifies a direct conversation between two members. Its canonical
+// string form lists the members in lexical order, so that "john:doe" and
+// "doe:john" address the same conversation.
+type chatID struct {
+	members [2]string
+}
+
+// parseChat parses a direct chat identifier of the form "<member1>:<member2>".
+func parseChat(chat string) (chatID, error) {
+	member1, member2, ok := strings.Cut(chat, ":")
+	if !ok || member1 == "" || member2 == "" || strings.Contains(member2, ":") {
+		return chatID{}, fmt.Errorf("%w %q, expected format \"<member1>:<member2>\"", errInvalidChat, chat)
+	}
+	if member1 == member2 {
+		return chatID{}, fmt.Errorf("%w %q, members must be different", errInvalidChat, chat)
+	}
+	if member1 > member2 {
+		member1, member2 = member2, member1
+	}
+	return chatID{members: [2]string{member1, member2}}, nil
+}
+
+// String returns the canonical form of the chat identifier.
+func (c chatID) String() string {
+	return c.members[0] + ":" + c.members[1]
+}
+
+// isGroupChat reports whether chat names a group chat rather than a direct
+// chat. Group chat IDs never contain a colon.
+func isGroupChat(chat string) bool {
+	return chat != "" && !strings.Contains(chat, ":")
+}
+
+// newGroupChatID returns a random identifier for a new group chat.
+func newGroupChatID() (string, error) {
+	b := make([]byte, 8)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return "group-" + hex.EncodeToString(b), nil
+}
+
+// validateUser checks that user can be a chat member. Users must not contain
+// a colon, which separates the members of a direct chat identifier.
+func validateUser(user string) error {
+	if user == "" || strings.Contains(user, ":") {
+		return fmt.Errorf("invalid user %q", user)
+	}
+	return nil
+}
+
+// resolveChat returns the canonical identifier of chat and its members.
+func (s *IMServiceImpl) resolveChat(ctx context.Context, chat string) (string, []string, error) {
+	if isGroupChat(chat) {
+		members, err := s.members.Members(ctx, chat)
+		if err != nil {
+			return "", nil, err
+		}
+		return chat, members, nil
+	}
+	id, err := parseChat(chat)
+	if err != nil {
+		return "", nil, err
+	}
+	return id.String(), id.members[:], nil
+}
@@ -0,0 +1,36 @@
+package main
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseChat(t *testing.T) {
+	tests := []struct {
+		name    string
+		chat    string
+		want    string
+		wantErr bool
+	}{
+		{name: "canonical", chat: "doe:john", want: "doe:john"},
+		{name: "reordered", chat: "john:doe", want: "doe:john"},
+		{name: "empty", chat: "", wantErr: true},
+		{name: "single member", chat: ":john", wantErr: true},
+		{name: "empty member", chat: "john:", wantErr: true},
+		{name: "three members", chat: "a:b:c", wantErr: true},
+		{name: "same member", chat: "john:john", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := parseChat(tt.chat)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, got.String())
+			assert.ElementsMatch(t, []string{"john", "doe"}, got.members[:])
+		})
+	}
+}
@@ -0,0 +1,17 @@
+package main
+
+import "github.com/TikTokTechImmersion/assignment_demo_2023/rpc-server/kitex_gen/rpc"
+
+// The codes of the ErrorCode catalogue of the IDL, typed as the Code of
+// responses.
+const (
+	codeInvalidArgument  = int32(rpc.ErrorCode_INVALID_ARGUMENT)
+	codeUnauthenticated  = int32(rpc.ErrorCode_UNAUTHENTICATED)
+	codePermissionDenied = int32(rpc.ErrorCode_PERMISSION_DENIED)
+	codeNotFound         = int32(rpc.ErrorCode_NOT_FOUND)
+	codeAlreadyExists    = int32(rpc.ErrorCode_ALREADY_EXISTS)
+	codeDeleted          = int32(rpc.ErrorCode_DELETED)
+	codeRateLimited      = int32(rpc.ErrorCode_RATE_LIMITED)
+	codeInternal         = int32(rpc.ErrorCode_INTERNAL)
+	codeUnavailable      = int32(rpc.ErrorCode_UNAVAILABLE)
+)
@@ -0,0 +1,116 @@
+package main
+
+import (
+	"context"
+	"expvar"
+	"time"
+
+	"github.com/TikTokTechImmersion/assignment_demo_2023/rpc-server/kitex_gen/rpc"
+	"github.com/cloudwego/kitex/pkg/klog"
+)
+
+// retentionMetrics are the metrics of the compactor, published by expvar as
+// "retention".
+var retentionMetrics = expvar.NewMap("retention")
+
+// compactor purges the messages expired by the retention policies of chats
+// from store, and from inbox and search, detaching their blobs in blobs.
+type compactor struct {
+	store     MessageStore
+	retention RetentionStore
+	inbox     InboxStore
+	search    SearchIndex
+	blobs     BlobRefStore
+}
+
+// run compacts every interval until ctx is done.
+func (c *compactor) run(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			c.compact(ctx)
+		}
+	}
+}
+
+// compact purges the expired messages of every chat with a retention policy
+// and returns how many it purged. Chats failing to compact are logged and
+// tried again on the next run.
+func (c *compactor) compact(ctx context.Context) int {
+	start := time.Now()
+	retentionMetrics.Add("runs", 1)
+	defer func() {
+		d := new(expvar.Int)
+		d.Set(time.Since(start).Microseconds())
+		retentionMetrics.Set("last_run_us", d)
+	}()
+	chats, err := c.retention.Chats(ctx)
+	if err != nil {
+		retentionMetrics.Add("errors", 1)
+		klog.CtxErrorf(ctx, "failed to list chats to compact: %v", err)
+		return 0
+	}
+	purged := 0
+	for _, chat := range chats {
+		n, err := c.compactChat(ctx, chat, start.UnixMicro())
+		if err != nil {
+			retentionMetrics.Add("errors", 1)
+			klog.CtxErrorf(ctx, "failed to compact chat %q: %v", chat, err)
+		}
+		purged += n
+	}
+	retentionMetrics.Add("purged_messages", int64(purged))
+	return purged
+}
+
+// compactChat purges the messages of chat expired at now.
+func (c *compactor) compactChat(ctx context.Context, chat string, now int64) (int, error) {
+	q, err := purgeQuery(ctx, c.store, c.retention, chat, now)
+	if err != nil {
+		return 0, err
+	}
+	msgs, err := c.store.Purge(ctx, q)
+	if err != nil || len(msgs) == 0 {
+		return 0, err
+	}
+	ids := make([]int64, 0, len(msgs))
+	for _, msg := range msgs {
+		ids = append(ids, msg.ID)
+	}
+	if keys := attachmentKeys(msgs...); len(keys) > 0 {
+		if err := c.blobs.Detach(ctx, keys...); err != nil {
+			klog.CtxErrorf(ctx, "failed to detach %d blobs of the purged messages of chat %q: %v", len(keys), chat, err)
+		}
+	}
+	// The messages are purged already, inboxes hide expired last messages
+	// and stale index entries are skipped by searches anyway.
+	if err := c.purgeInbox(ctx, chat, ids); err != nil {
+		klog.CtxErrorf(ctx, "failed to remove %d purged messages of chat %q from the inboxes: %v", len(ids), chat, err)
+	}
+	tombstones := make([]*rpc.Message, 0, len(ids))
+	for _, id := range ids {
+		tombstones = append(tombstones, &rpc.Message{Chat: chat, ID: id, Deleted: true})
+	}
+	if err := c.search.Index(ctx, tombstones...); err != nil {
+		klog.CtxErrorf(ctx, "failed to remove %d purged messages of chat %q from the index: %v", len(ids), chat, err)
+	}
+	return len(ids), nil
+}
+
+// purgeInbox removes the messages of chat with IDs ids from the inboxes, the
+// latest message left becoming the last message of the chat.
+func (c *compactor) purgeInbox(ctx context.Context, chat string, ids []int64) error {
+	msgs, err := c.store.Range(ctx, chat, 0, 1, true)
+	if err != nil {
+		return err
+	}
+	var last int64
+	if len(msgs) > 0 {
+		last = msgs[0].ID
+	}
+	return c.inbox.Purge(ctx, chat, ids, last)
+}
@@ -0,0 +1,84 @@
+package main
+
+import (
+	"context"
+	"expvar"
+	"testing"
+
+	"github.com/TikTokTechImmersion/assignment_demo_2023/rpc-server/kitex_gen/rpc"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCompactor(t *testing.T) {
+	ctx := context.Background()
+	s := newTestService(t)
+	store := s.store.(*retainedStore).MessageStore
+	c := &compactor{store: store, retention: s.retention, inbox: s.inbox, search: s.search, blobs: s.blobs}
+	purged := func() int64 {
+		v, _ := retentionMetrics.Get("purged_messages").(*expvar.Int)
+		if v == nil {
+			return 0
+		}
+		return v.Value()
+	}
+	before := purged()
+
+	assert.NoError(t, store.Append(ctx,
+		&rpc.Message{Chat: "doe:john", Text: "hello 1", SendTime: 10},
+		&rpc.Message{Chat: "doe:john", Text: "hello 2", SendTime: 20},
+		&rpc.Message{Chat: "doe:john", Text: "hello 3", SendTime: 30, ExpireTime: 40},
+		&rpc.Message{Chat: "doe:john", Text: "hello 4", SendTime: 50},
+		&rpc.Message{Chat: "a:b", Text: "hello", SendTime: 10},
+	))
+	assert.NoError(t, s.search.Index(ctx, &rpc.Message{Chat: "doe:john", ID: 1, SendTime: 10, Text: "hello 1"}))
+	msgs, err := store.Range(ctx, "doe:john", 0, 0, false)
+	assert.NoError(t, err)
+	for _, msg := range msgs {
+		msg.Sender = "doe"
+		assert.NoError(t, s.inbox.Post(ctx, []InboxPost{{Message: msg, Members: []string{"doe", "john"}}}))
+	}
+	assert.Equal(t, 0, c.compact(ctx))
+
+	assert.NoError(t, s.retention.SetRetention(ctx, "doe:john", &rpc.Retention{MaxCount: 3}))
+	assert.Equal(t, 2, c.compact(ctx))
+	assert.Equal(t, before+2, purged())
+	msgs, err = store.Range(ctx, "doe:john", 0, 0, false)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"hello 2", "hello 4"}, texts(msgs))
+	msgs, err = store.Range(ctx, "a:b", 0, 0, false)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"hello"}, texts(msgs))
+	hits, err := s.search.Search(ctx, []string{"doe:john"}, "hello", 0, 10)
+	assert.NoError(t, err)
+	assert.Empty(t, hits)
+	entries, err := s.inbox.List(ctx, "john", 0, 0)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(4), entries[0].LastID)
+	assert.Equal(t, int64(2), entries[0].Unread)
+
+	assert.NoError(t, s.retention.SetRetention(ctx, "doe:john", &rpc.Retention{MaxCount: 1}))
+	assert.Equal(t, 1, c.compact(ctx))
+	entries, err = s.inbox.List(ctx, "john", 0, 0)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(4), entries[0].LastID)
+	assert.Equal(t, int64(1), entries[0].Unread)
+
+	// Purging the last message of a chat moves it back to the latest left.
+	// Its attachment is released.
+	assert.NoError(t, s.retention.SetRetention(ctx, "doe:john", &rpc.Retention{MaxCount: 2}))
+	assert.NoError(t, store.Append(ctx, &rpc.Message{Chat: "doe:john", Text: "hello 5", SendTime: 60, ExpireTime: 70,
+		Type: rpc.ContentType_FILE, Attachment: &rpc.Attachment{Key: "k5"}}))
+	msgs, err = store.Range(ctx, "doe:john", 60, 0, false)
+	assert.NoError(t, err)
+	assert.NoError(t, s.inbox.Post(ctx, []InboxPost{{Message: msgs[0], Members: []string{"doe", "john"}}}))
+	assert.Equal(t, 1, c.compact(ctx))
+	entries, err = s.inbox.List(ctx, "john", 0, 0)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(4), entries[0].LastID)
+	assert.Equal(t, int64(1), entries[0].Unread)
+	keys, _, err := s.blobs.Released(ctx, "test", 0, 0)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"k5"}, keys)
+
+	assert.Equal(t, 0, c.compact(ctx))
+}
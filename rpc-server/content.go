@@ -0,0 +1,41 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/TikTokTechImmersion/assignment_demo_2023/rpc-server/kitex_gen/rpc"
+)
+
+// maxAttachmentNameLen bounds the length of the file names of attachments.
+const maxAttachmentNameLen = 255
+
+// checkContent checks that the content of msg matches its type: text
+// messages have no attachment, while image and file messages have one, which
+// must be an image for image messages. Attachments are uploaded to the blob
+// store of the http-server, which fills in their metadata.
+func checkContent(msg *rpc.Message) error {
+	switch msg.Type {
+	case rpc.ContentType_TEXT:
+		if msg.Attachment != nil {
+			return errors.New("text messages have no attachment")
+		}
+		return nil
+	case rpc.ContentType_IMAGE, rpc.ContentType_FILE:
+	default:
+		return fmt.Errorf("unknown content type %d", msg.Type)
+	}
+	a := msg.Attachment
+	switch {
+	case a == nil || a.Key == "":
+		return fmt.Errorf("%s messages need an attachment", strings.ToLower(msg.Type.String()))
+	case len(a.Name) > maxAttachmentNameLen:
+		return fmt.Errorf("attachment name is longer than %d bytes", maxAttachmentNameLen)
+	case a.Size < 0:
+		return fmt.Errorf("invalid attachment size %d", a.Size)
+	case msg.Type == rpc.ContentType_IMAGE && !strings.HasPrefix(a.MimeType, "image/"):
+		return fmt.Errorf("attachment of type %q is not an image", a.MimeType)
+	}
+	return nil
+}
@@ -0,0 +1,38 @@
+package main
+
+import (
+	"testing"
+
+	"github.com/TikTokTechImmersion/assignment_demo_2023/rpc-server/kitex_gen/rpc"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCheckContent(t *testing.T) {
+	image := &rpc.Attachment{Key: "k", Name: "cat.png", MimeType: "image/png", Size: 3}
+	pdf := &rpc.Attachment{Key: "k", Name: "doc.pdf", MimeType: "application/pdf", Size: 3}
+	tests := []struct {
+		name    string
+		msg     *rpc.Message
+		wantErr bool
+	}{
+		{name: "text", msg: &rpc.Message{Text: "hi"}},
+		{name: "text with attachment", msg: &rpc.Message{Text: "hi", Attachment: image}, wantErr: true},
+		{name: "image", msg: &rpc.Message{Type: rpc.ContentType_IMAGE, Attachment: image}},
+		{name: "captioned image", msg: &rpc.Message{Type: rpc.ContentType_IMAGE, Text: "cat", Attachment: image}},
+		{name: "image without attachment", msg: &rpc.Message{Type: rpc.ContentType_IMAGE}, wantErr: true},
+		{name: "image of another type", msg: &rpc.Message{Type: rpc.ContentType_IMAGE, Attachment: pdf}, wantErr: true},
+		{name: "file", msg: &rpc.Message{Type: rpc.ContentType_FILE, Attachment: pdf}},
+		{name: "file without key", msg: &rpc.Message{Type: rpc.ContentType_FILE, Attachment: &rpc.Attachment{Name: "doc.pdf"}}, wantErr: true},
+		{name: "unknown type", msg: &rpc.Message{Type: 3, Attachment: pdf}, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := checkContent(tt.msg)
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
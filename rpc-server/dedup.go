@@ -0,0 +1,105 @@
+package main
+
+import (
+	"container/list"
+	"context"
+	"sync"
+	"time"
+)
+
+const (
+	// sendDedupWindow is how long the result of a Send is remembered by its
+	// idempotency key.
+	sendDedupWindow = 10 * time.Minute
+	// sendDedupMaxKeys bounds the number of idempotency keys remembered, the
+	// oldest keys are forgotten first.
+	sendDedupMaxKeys = 100000
+)
+
+// dedupCache remembers the ID and send time of the messages sent with an
+// idempotency key, so that retried Send calls return the original message.
+// Like the notifier, it only deduplicates the calls reaching the same
+// rpc-server process.
+type dedupCache struct {
+	mu      sync.Mutex
+	window  time.Duration
+	maxKeys int
+	keys    map[string]*dedupEntry
+	order   *list.List // of *dedupEntry, oldest first
+}
+
+// dedupEntry is the result of the Send holding a key, available once done is
+// closed. A failed Send leaves ok false and releases the key.
+type dedupEntry struct {
+	key      string
+	expires  time.Time
+	done     chan struct{}
+	ok       bool
+	id       int64
+	sendTime int64
+}
+
+func newDedupCache(window time.Duration, maxKeys int) *dedupCache {
+	return &dedupCache{
+		window:  window,
+		maxKeys: maxKeys,
+		keys:    make(map[string]*dedupEntry),
+		order:   list.New(),
+	}
+}
+
+// acquire returns the entry of key. If fresh is true, the caller holds the
+// key and must call release once its Send completes. Otherwise the entry
+// holds the result of a previous Send, waiting for it if still in progress.
+func (d *dedupCache) acquire(ctx context.Context, key string) (e *dedupEntry, fresh bool, err error) {
+	for {
+		d.mu.Lock()
+		d.expire(time.Now())
+		e, ok := d.keys[key]
+		if !ok {
+			e = &dedupEntry{key: key, expires: time.Now().Add(d.window), done: make(chan struct{})}
+			d.keys[key] = e
+			d.order.PushBack(e)
+			d.mu.Unlock()
+			return e, true, nil
+		}
+		d.mu.Unlock()
+
+		select {
+		case <-e.done:
+		case <-ctx.Done():
+			return nil, false, ctx.Err()
+		}
+		if e.ok {
+			return e, false, nil
+		}
+		// The Send holding the key failed, try to take it over.
+	}
+}
+
+// release records the result of the Send holding e, forgetting its key if
+// the Send failed.
+func (d *dedupCache) release(e *dedupEntry, ok bool, id, sendTime int64) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	e.ok, e.id, e.sendTime = ok, id, sendTime
+	if !ok && d.keys[e.key] == e {
+		delete(d.keys, e.key)
+	}
+	close(e.done)
+}
+
+// expire forgets the keys that expired by now, and the oldest keys beyond
+// maxKeys. Entries share the same window, so they expire in insertion order.
+func (d *dedupCache) expire(now time.Time) {
+	for front := d.order.Front(); front != nil; front = d.order.Front() {
+		e := front.Value.(*dedupEntry)
+		if now.Before(e.expires) && d.order.Len() < d.maxKeys {
+			return
+		}
+		d.order.Remove(front)
+		if d.keys[e.key] == e {
+			delete(d.keys, e.key)
+		}
+	}
+}
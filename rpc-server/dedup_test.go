@@ -0,0 +1,51 @@
+package main
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDedupCache(t *testing.T) {
+	ctx := context.Background()
+	d := newDedupCache(50*time.Millisecond, 2)
+
+	e, fresh, err := d.acquire(ctx, "a")
+	assert.NoError(t, err)
+	assert.True(t, fresh)
+	// A retry waits for the Send holding the key.
+	go func() {
+		time.Sleep(10 * time.Millisecond)
+		d.release(e, true, 1, 100)
+	}()
+	got, fresh, err := d.acquire(ctx, "a")
+	assert.NoError(t, err)
+	assert.False(t, fresh)
+	assert.Equal(t, int64(1), got.id)
+	assert.Equal(t, int64(100), got.sendTime)
+
+	// A failed Send releases the key.
+	e, _, _ = d.acquire(ctx, "b")
+	d.release(e, false, 0, 0)
+	_, fresh, _ = d.acquire(ctx, "b")
+	assert.True(t, fresh)
+
+	// Keys beyond the limit evict the oldest ones.
+	e, _, _ = d.acquire(ctx, "c")
+	d.release(e, true, 3, 300)
+	_, fresh, _ = d.acquire(ctx, "a")
+	assert.True(t, fresh)
+
+	// Keys are forgotten once the window elapses.
+	time.Sleep(60 * time.Millisecond)
+	_, fresh, _ = d.acquire(ctx, "c")
+	assert.True(t, fresh)
+
+	// Waiting for a key in progress stops with the context.
+	cctx, cancel := context.WithTimeout(ctx, 10*time.Millisecond)
+	defer cancel()
+	_, _, err = d.acquire(cctx, "c")
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+}
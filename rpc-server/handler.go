@@ -2,30 +2,393 @@ package main
 
 import (
 	"context"
-	"math/rand"
+	"errors"
+	"fmt"
+	"reflect"
+	"time"
 
 	"github.com/TikTokTechImmersion/assignment_demo_2023/rpc-server/kitex_gen/rpc"
+	"github.com/cloudwego/kitex/pkg/klog"
 )
 
+// errPermissionDenied is returned when a user may not change a message.
+var errPermissionDenied = errors.New("permission denied")
+
 // IMServiceImpl implements the last service interface defined in the IDL.
-type IMServiceImpl struct{}
+type IMServiceImpl struct {
+	store     MessageStore
+	members   MemberStore
+	inbox     InboxStore
+	search    SearchIndex
+	retention RetentionStore
+	blobs     BlobRefStore
+	limits    *rateLimits
+	notifier  *notifier
+	sent      *dedupCache
+}
+
+// NewIMServiceImpl creates an IMServiceImpl that keeps messages in store, the
+// members of group chats in members, the chats of every user in inbox, the
+// text of messages in search, the retention policies of chats in retention
+// and the messages attached to every blob in blobs. Messages expired by their
+// policies are never read from store, whether or not a compactor purged them
+// yet. Sends and pulls are rate limited by limits, unless nil.
+func NewIMServiceImpl(store MessageStore, members MemberStore, inbox InboxStore, search SearchIndex,
+	retention RetentionStore, blobs BlobRefStore, limits *rateLimits) *IMServiceImpl {
+	return &IMServiceImpl{
+		store:     &retainedStore{MessageStore: store, retention: retention},
+		members:   members,
+		inbox:     inbox,
+		search:    search,
+		retention: retention,
+		blobs:     blobs,
+		limits:    limits,
+		notifier:  newNotifier(),
+		sent:      newDedupCache(sendDedupWindow, sendDedupMaxKeys),
+	}
+}
 
 func (s *IMServiceImpl) Send(ctx context.Context, req *rpc.SendRequest) (*rpc.SendResponse, error) {
-	resp := rpc.NewSendResponse()
-	resp.Code, resp.Msg = areYouLucky()
-	return resp, nil
+	return s.sendBatch(ctx, []*rpc.SendRequest{req})[0], nil
+}
+
+// maxIdempotencyKeyLen bounds the length of SendRequest.IdempotencyKey.
+const maxIdempotencyKeyLen = 255
+
+// sendBatch validates every request, stores the messages of the valid ones
+// with a single Append and returns the response of every request, in order.
+func (s *IMServiceImpl) sendBatch(ctx context.Context, reqs []*rpc.SendRequest) []*rpc.SendResponse {
+	type pending struct {
+		resp    *rpc.SendResponse
+		msg     *rpc.Message
+		members []string
+		ttl     int64       // disappear after, in seconds, if positive
+		entry   *dedupEntry // holding the idempotency key of the request, if any
+	}
+	var (
+		resps   = make([]*rpc.SendResponse, len(reqs))
+		batch   []pending
+		chats   = make(map[string]*resolvedChat)
+		held    = make(map[string]*rpc.SendResponse) // by the idempotency keys held by the batch
+		repeats = make(map[*rpc.SendResponse]*rpc.SendResponse)
+	)
+	for i, req := range reqs {
+		resp := rpc.NewSendResponse()
+		resps[i] = resp
+		msg := req.GetMessage()
+		if msg == nil {
+			resp.Code, resp.Msg = codeInvalidArgument, "message is required"
+			continue
+		}
+		c, ok := chats[msg.Chat]
+		if !ok {
+			c = new(resolvedChat)
+			c.chat, c.members, c.err = s.resolveChat(ctx, msg.Chat)
+			if c.err == nil {
+				c.retention, c.err = s.retention.Retention(ctx, c.chat)
+			}
+			chats[msg.Chat] = c
+		}
+		if c.err != nil {
+			resp.Code, resp.Msg = errorCode(c.err), c.err.Error()
+			continue
+		}
+		if !containsString(c.members, msg.Sender) {
+			resp.Code, resp.Msg = codePermissionDenied, fmt.Sprintf("sender %q is not a member of chat %q", msg.Sender, msg.Chat)
+			continue
+		}
+		if err := checkContent(msg); err != nil {
+			resp.Code, resp.Msg = codeInvalidArgument, err.Error()
+			continue
+		}
+		msg.Chat = c.chat
+		msg.Edited, msg.Deleted, msg.RootID, msg.Reactions = false, false, 0, nil
+		if msg.ReplyTo != 0 {
+			root, err := s.threadRoot(ctx, c.chat, msg.ReplyTo)
+			if err != nil {
+				resp.Code, resp.Msg = errorCode(err), fmt.Sprintf("message %d replied to: %v", msg.ReplyTo, err)
+				continue
+			}
+			msg.RootID = root
+		}
+		key := req.GetIdempotencyKey()
+		if key == "" {
+			if wait, why := s.limits.send(ctx, msg.Sender, c.chat); wait > 0 {
+				resp.Code, resp.Msg, resp.RetryAfterMs = codeRateLimited, why, retryAfterMs(wait)
+				continue
+			}
+			batch = append(batch, pending{resp: resp, msg: msg, members: c.members, ttl: c.retention.DisappearAfter})
+			continue
+		} else if len(key) > maxIdempotencyKeyLen {
+			resp.Code, resp.Msg = codeInvalidArgument, fmt.Sprintf("idempotency key is longer than %d bytes", maxIdempotencyKeyLen)
+			continue
+		}
+		// Keys are scoped to the sender and chat, so clients cannot collide
+		// with each other.
+		key = c.chat + "\x00" + msg.Sender + "\x00" + key
+		if first, ok := held[key]; ok {
+			// Waiting for the key would wait for this very batch.
+			repeats[resp] = first
+			continue
+		}
+		e, fresh, err := s.sent.acquire(ctx, key)
+		if err != nil {
+			resp.Code, resp.Msg = codeUnavailable, err.Error()
+		} else if !fresh {
+			// Retries of delivered messages are not charged to the limits.
+			resp.Code, resp.Msg = 0, "success"
+			resp.ID, resp.SendTime = &e.id, &e.sendTime
+		} else if wait, why := s.limits.send(ctx, msg.Sender, c.chat); wait > 0 {
+			resp.Code, resp.Msg, resp.RetryAfterMs = codeRateLimited, why, retryAfterMs(wait)
+			s.sent.release(e, false, 0, 0)
+		} else {
+			held[key] = resp
+			batch = append(batch, pending{resp: resp, msg: msg, members: c.members, ttl: c.retention.DisappearAfter, entry: e})
+		}
+	}
+
+	msgs := make([]*rpc.Message, 0, len(batch))
+	// Send and expiry times are assigned by the server, clients cannot forge
+	// them.
+	now := time.Now().UnixMicro()
+	for _, p := range batch {
+		p.msg.SendTime, p.msg.ExpireTime = now, 0
+		if p.ttl > 0 {
+			p.msg.ExpireTime = now + p.ttl*1e6
+		}
+		msgs = append(msgs, p.msg)
+	}
+	var err error
+	if len(msgs) > 0 {
+		err = s.store.Append(ctx, msgs...)
+	}
+	notified := make(map[string]bool)
+	posts := make([]InboxPost, 0, len(batch))
+	for _, p := range batch {
+		if p.entry != nil {
+			s.sent.release(p.entry, err == nil, p.msg.ID, p.msg.SendTime)
+		}
+		if err != nil {
+			p.resp.Code, p.resp.Msg = codeUnavailable, err.Error()
+			continue
+		}
+		p.resp.Code, p.resp.Msg = 0, "success"
+		p.resp.ID, p.resp.SendTime = &p.msg.ID, &p.msg.SendTime
+		if !notified[p.msg.Chat] {
+			s.notifier.notify(p.msg.Chat)
+			notified[p.msg.Chat] = true
+		}
+		posts = append(posts, InboxPost{Message: p.msg, Members: p.members})
+	}
+	if len(posts) > 0 {
+		// The messages are stored already, failing the requests would only
+		// make clients send them again.
+		if err := s.inbox.Post(ctx, posts); err != nil {
+			klog.CtxErrorf(ctx, "failed to post %d messages to inboxes: %v", len(posts), err)
+		}
+		if err := s.search.Index(ctx, msgs...); err != nil {
+			klog.CtxErrorf(ctx, "failed to index %d messages: %v", len(msgs), err)
+		}
+		// Blobs attached to no message are deleted, see BlobRefStore.
+		if keys := attachmentKeys(msgs...); len(keys) > 0 {
+			if err := s.blobs.Attach(ctx, keys...); err != nil {
+				klog.CtxErrorf(ctx, "failed to attach %d blobs: %v", len(keys), err)
+			}
+		}
+	}
+	for resp, first := range repeats {
+		resp.Code, resp.Msg, resp.ID, resp.SendTime = first.Code, first.Msg, first.ID, first.SendTime
+	}
+	return resps
+}
+
+// resolvedChat caches the result of resolveChat.
+type resolvedChat struct {
+	chat      string
+	members   []string
+	retention *rpc.Retention
+	err       error
 }
 
 func (s *IMServiceImpl) Pull(ctx context.Context, req *rpc.PullRequest) (*rpc.PullResponse, error) {
 	resp := rpc.NewPullResponse()
-	resp.Code, resp.Msg = areYouLucky()
+	chat, members, err := s.resolveChat(ctx, req.Chat)
+	if err != nil {
+		resp.Code, resp.Msg = errorCode(err), err.Error()
+		return resp, nil
+	}
+	if !containsString(members, req.GetUser()) {
+		resp.Code, resp.Msg = codePermissionDenied, fmt.Sprintf("user %q is not a member of chat %q", req.GetUser(), req.Chat)
+		return resp, nil
+	}
+	if wait, why := s.limits.pull(ctx, req.GetUser(), chat); wait > 0 {
+		resp.Code, resp.Msg, resp.RetryAfterMs = codeRateLimited, why, retryAfterMs(wait)
+		return resp, nil
+	}
+	if req.GetChanges() && req.GetReverse() {
+		resp.Code, resp.Msg = codeInvalidArgument, "changes can only be pulled in ascending order"
+		return resp, nil
+	}
+	limit := int(req.Limit)
+	if limit <= 0 {
+		limit = defaultPullLimit
+	}
+	wait := time.Duration(req.GetWaitMs()) * time.Millisecond
+	if wait > maxPullWait {
+		wait = maxPullWait
+	}
+	msgs, hasMore, nextCursor, err := s.waitPage(ctx, chat, req.Cursor, limit, req.GetReverse(), req.GetChanges(), wait)
+	if err != nil {
+		resp.Code, resp.Msg = codeUnavailable, err.Error()
+		return resp, nil
+	}
+	positions, err := s.readPositions(ctx, chat, members)
+	if err != nil {
+		resp.Code, resp.Msg = codeUnavailable, err.Error()
+		return resp, nil
+	}
+	resp.Code, resp.Msg = 0, "success"
+	resp.Messages = msgs
+	resp.ReadPositions = positions
+	resp.HasMore = &hasMore
+	if hasMore {
+		resp.NextCursor = &nextCursor
+	}
 	return resp, nil
 }
 
-func areYouLucky() (int32, string) {
-	if rand.Int31n(2) == 1 {
-		return 0, "success"
-	} else {
-		return 500, "oops"
+const (
+	// defaultPullLimit is the page size used when PullRequest.Limit is not set.
+	defaultPullLimit = 10
+	// maxPullWait bounds how long PullRequest.WaitMs can hold a Pull.
+	maxPullWait = 30 * time.Second
+)
+
+// pullPage reads one page of up to limit messages and the inclusive cursor of
+// the page after it. Send times are unique within a chat, so the cursor
+// points at exactly one message and pages never overlap or skip messages.
+// If changes is true, the page holds the messages sent or updated from
+// cursor on instead, and cursors are update times, unique too.
+func (s *IMServiceImpl) pullPage(ctx context.Context, chat string, cursor int64, limit int, reverse, changes bool) ([]*rpc.Message, bool, int64, error) {
+	if changes {
+		msgs, err := s.store.Changes(ctx, chat, cursor, limit+1)
+		if err != nil {
+			return nil, false, 0, err
+		}
+		if len(msgs) <= limit {
+			return msgs, false, 0, nil
+		}
+		return msgs[:limit], true, msgs[limit].UpdateTime, nil
+	}
+	msgs, err := s.store.Range(ctx, chat, cursor, limit+1, reverse)
+	if err != nil {
+		return nil, false, 0, err
+	}
+	if len(msgs) <= limit {
+		return msgs, false, 0, nil
+	}
+	return msgs[:limit], true, msgs[limit].SendTime, nil
+}
+
+// page is a page of messages read by pullPages.
+type page struct {
+	msgs       []*rpc.Message
+	hasMore    bool
+	nextCursor int64
+}
+
+// pullPages is pullPage for every query, read with a single MultiRange.
+func (s *IMServiceImpl) pullPages(ctx context.Context, queries []RangeQuery) ([]page, error) {
+	plusOne := make([]RangeQuery, 0, len(queries))
+	for _, q := range queries {
+		q.Limit++
+		plusOne = append(plusOne, q)
+	}
+	results, err := s.store.MultiRange(ctx, plusOne)
+	if err != nil {
+		return nil, err
+	}
+	pages := make([]page, 0, len(queries))
+	for i, msgs := range results {
+		limit := queries[i].Limit
+		if len(msgs) <= limit {
+			pages = append(pages, page{msgs: msgs})
+		} else {
+			pages = append(pages, page{msgs: msgs[:limit], hasMore: true, nextCursor: msgs[limit].SendTime})
+		}
+	}
+	return pages, nil
+}
+
+// waitPage is pullPage, except that when no message follows cursor in
+// ascending order it waits up to wait for a message to be sent to chat, or
+// updated if changes is true.
+func (s *IMServiceImpl) waitPage(ctx context.Context, chat string, cursor int64, limit int, reverse, changes bool, wait time.Duration) ([]*rpc.Message, bool, int64, error) {
+	if reverse {
+		wait = 0
+	}
+	var (
+		msgs       []*rpc.Message
+		hasMore    bool
+		nextCursor int64
+	)
+	err := s.waitFor(ctx, []string{chat}, wait, func() (found bool, err error) {
+		msgs, hasMore, nextCursor, err = s.pullPage(ctx, chat, cursor, limit, reverse, changes)
+		return len(msgs) > 0, err
+	})
+	return msgs, hasMore, nextCursor, err
+}
+
+// waitFor calls read until it finds messages, wait elapses or ctx is done.
+// In between, it sleeps until a message is sent to one of chats.
+func (s *IMServiceImpl) waitFor(ctx context.Context, chats []string, wait time.Duration, read func() (bool, error)) error {
+	if wait <= 0 {
+		_, err := read()
+		return err
+	}
+	timer := time.NewTimer(wait)
+	defer timer.Stop()
+	for {
+		cases := []reflect.SelectCase{
+			{Dir: reflect.SelectRecv, Chan: reflect.ValueOf(timer.C)},
+			{Dir: reflect.SelectRecv, Chan: reflect.ValueOf(ctx.Done())},
+		}
+		// Subscribe before reading, so that a message sent in between is not missed.
+		dones := make([]func(), 0, len(chats))
+		for _, chat := range chats {
+			sent, done := s.notifier.subscribe(chat)
+			cases = append(cases, reflect.SelectCase{Dir: reflect.SelectRecv, Chan: reflect.ValueOf(sent)})
+			dones = append(dones, done)
+		}
+		found, err := read()
+		chosen := 0
+		if err == nil && !found {
+			chosen, _, _ = reflect.Select(cases)
+		}
+		for _, done := range dones {
+			done()
+		}
+		if err != nil || found || chosen < 2 {
+			return err
+		}
+	}
+}
+
+// errorCode returns the response code reporting err. Errors not known to the
+// handlers are failures of the stores, which may be transient.
+func errorCode(err error) int32 {
+	switch {
+	case errors.Is(err, errInvalidChat):
+		return codeInvalidArgument
+	case errors.Is(err, errChatNotFound), errors.Is(err, errMessageNotFound):
+		return codeNotFound
+	case errors.Is(err, errChatExists):
+		return codeAlreadyExists
+	case errors.Is(err, errPermissionDenied):
+		return codePermissionDenied
+	case errors.Is(err, errMessageDeleted):
+		return codeDeleted
+	default:
+		return codeUnavailable
 	}
 }
@@ -0,0 +1,283 @@
+package main
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/TikTokTechImmersion/assignment_demo_2023/rpc-server/kitex_gen/rpc"
+	"golang.org/x/crypto/bcrypt"
+)
+
+const (
+	// maxUserNameLen bounds the length of the names of registered users.
+	maxUserNameLen = 64
+	// minPasswordLen and maxPasswordLen bound the length of passwords, bcrypt
+	// ignores the bytes past the 72nd.
+	minPasswordLen = 8
+	maxPasswordLen = 72
+	// bcryptCost is the cost of the password hashes.
+	bcryptCost = bcrypt.DefaultCost
+)
+
+// errInvalidCredentials is returned for any failed login, so that it does not
+// tell whether the user is registered.
+const errInvalidCredentials = "invalid user or password"
+
+// AuthServiceImpl implements the AuthService defined in the IDL.
+type AuthServiceImpl struct {
+	users      UserStore
+	sessions   SessionStore
+	tokens     *tokenIssuer
+	refreshTTL time.Duration
+	// dummyHash is compared to the passwords of unknown users, so that
+	// logins take as long whether the user is registered or not.
+	dummyHash []byte
+}
+
+// NewAuthServiceImpl creates an AuthServiceImpl that keeps users in users,
+// their sessions in sessions and signs access tokens with tokens. Refresh
+// tokens are valid for refreshTTL.
+func NewAuthServiceImpl(users UserStore, sessions SessionStore, tokens *tokenIssuer, refreshTTL time.Duration) (*AuthServiceImpl, error) {
+	dummyHash, err := bcrypt.GenerateFromPassword([]byte("not a password"), bcryptCost)
+	if err != nil {
+		return nil, err
+	}
+	return &AuthServiceImpl{
+		users:      users,
+		sessions:   sessions,
+		tokens:     tokens,
+		refreshTTL: refreshTTL,
+		dummyHash:  dummyHash,
+	}, nil
+}
+
+func (s *AuthServiceImpl) Register(ctx context.Context, req *rpc.RegisterRequest) (*rpc.RegisterResponse, error) {
+	resp := rpc.NewRegisterResponse()
+	if err := validateUser(req.User); err != nil {
+		resp.Code, resp.Msg = codeInvalidArgument, err.Error()
+		return resp, nil
+	} else if len(req.User) > maxUserNameLen {
+		resp.Code, resp.Msg = codeInvalidArgument, fmt.Sprintf("user is longer than %d bytes", maxUserNameLen)
+		return resp, nil
+	}
+	if len(req.Password) < minPasswordLen || len(req.Password) > maxPasswordLen {
+		resp.Code, resp.Msg = codeInvalidArgument, fmt.Sprintf("password must be between %d and %d bytes", minPasswordLen, maxPasswordLen)
+		return resp, nil
+	}
+	hash, err := bcrypt.GenerateFromPassword([]byte(req.Password), bcryptCost)
+	if err != nil {
+		resp.Code, resp.Msg = codeInternal, err.Error()
+		return resp, nil
+	}
+	if err := s.users.CreateUser(ctx, req.User, hash); errors.Is(err, errUserExists) {
+		resp.Code, resp.Msg = codeAlreadyExists, err.Error()
+		return resp, nil
+	} else if err != nil {
+		resp.Code, resp.Msg = codeUnavailable, err.Error()
+		return resp, nil
+	}
+	resp.Code, resp.Msg = 0, "success"
+	return resp, nil
+}
+
+func (s *AuthServiceImpl) Login(ctx context.Context, req *rpc.LoginRequest) (*rpc.LoginResponse, error) {
+	resp := rpc.NewLoginResponse()
+	hash, err := s.users.PasswordHash(ctx, req.User)
+	if errors.Is(err, errUserNotFound) {
+		bcrypt.CompareHashAndPassword(s.dummyHash, []byte(req.Password))
+		resp.Code, resp.Msg = codeUnauthenticated, errInvalidCredentials
+		return resp, nil
+	} else if err != nil {
+		resp.Code, resp.Msg = codeUnavailable, err.Error()
+		return resp, nil
+	}
+	if bcrypt.CompareHashAndPassword(hash, []byte(req.Password)) != nil {
+		resp.Code, resp.Msg = codeUnauthenticated, errInvalidCredentials
+		return resp, nil
+	}
+	id, err := newSessionID()
+	if err != nil {
+		resp.Code, resp.Msg = codeInternal, err.Error()
+		return resp, nil
+	}
+	secret, refresh, err := newRefreshSecret()
+	if err != nil {
+		resp.Code, resp.Msg = codeInternal, err.Error()
+		return resp, nil
+	}
+	now := time.Now()
+	err = s.sessions.CreateSession(ctx, &session{
+		ID:         id,
+		User:       req.User,
+		Refresh:    refresh,
+		ExpireTime: now.Add(s.refreshTTL).UnixMicro(),
+	})
+	if err != nil {
+		resp.Code, resp.Msg = codeUnavailable, err.Error()
+		return resp, nil
+	}
+	tokens, err := s.newTokens(req.User, id, secret, now)
+	if err != nil {
+		resp.Code, resp.Msg = codeInternal, err.Error()
+		return resp, nil
+	}
+	resp.Code, resp.Msg = 0, "success"
+	resp.Tokens = tokens
+	return resp, nil
+}
+
+// RefreshToken exchanges the latest refresh token of a session for new
+// tokens. Refresh tokens are used once: presenting one again means that it
+// leaked, and the session is revoked.
+func (s *AuthServiceImpl) RefreshToken(ctx context.Context, req *rpc.RefreshTokenRequest) (*rpc.RefreshTokenResponse, error) {
+	resp := rpc.NewRefreshTokenResponse()
+	sess, prev, code, msg := s.session(ctx, req.RefreshToken)
+	if code != 0 {
+		resp.Code, resp.Msg = code, msg
+		return resp, nil
+	}
+	secret, next, err := newRefreshSecret()
+	if err != nil {
+		resp.Code, resp.Msg = codeInternal, err.Error()
+		return resp, nil
+	}
+	now := time.Now()
+	err = s.sessions.RotateSession(ctx, sess.ID, prev, next, now.Add(s.refreshTTL).UnixMicro())
+	if errors.Is(err, errRefreshReused) {
+		resp.Code, resp.Msg = s.revokeReused(ctx, sess.ID)
+		return resp, nil
+	} else if err != nil {
+		resp.Code, resp.Msg = codeUnavailable, err.Error()
+		return resp, nil
+	}
+	tokens, err := s.newTokens(sess.User, sess.ID, secret, now)
+	if err != nil {
+		resp.Code, resp.Msg = codeInternal, err.Error()
+		return resp, nil
+	}
+	resp.Code, resp.Msg = 0, "success"
+	resp.Tokens = tokens
+	return resp, nil
+}
+
+// Logout revokes the session of a refresh token. Its access tokens are
+// rejected by the frontends once they fetched the revoked sessions again.
+func (s *AuthServiceImpl) Logout(ctx context.Context, req *rpc.LogoutRequest) (*rpc.LogoutResponse, error) {
+	resp := rpc.NewLogoutResponse()
+	sess, hash, code, msg := s.session(ctx, req.RefreshToken)
+	if code != 0 {
+		resp.Code, resp.Msg = code, msg
+		return resp, nil
+	}
+	if subtle.ConstantTimeCompare(sess.Refresh, hash) != 1 {
+		resp.Code, resp.Msg = s.revokeReused(ctx, sess.ID)
+		return resp, nil
+	}
+	if err := s.sessions.RevokeSession(ctx, sess.ID, time.Now().UnixMicro()); err != nil {
+		resp.Code, resp.Msg = codeUnavailable, err.Error()
+		return resp, nil
+	}
+	resp.Code, resp.Msg = 0, "success"
+	return resp, nil
+}
+
+func (s *AuthServiceImpl) GetKeySet(ctx context.Context, req *rpc.GetKeySetRequest) (*rpc.GetKeySetResponse, error) {
+	resp := rpc.NewGetKeySetResponse()
+	keySet, err := s.tokens.keySet()
+	if err != nil {
+		resp.Code, resp.Msg = codeInternal, err.Error()
+		return resp, nil
+	}
+	resp.Code, resp.Msg = 0, "success"
+	resp.KeySet = &keySet
+	return resp, nil
+}
+
+// RevokedSessions returns the sessions revoked within the lifetime of access
+// tokens, for the frontends to reject the access tokens issued before.
+func (s *AuthServiceImpl) RevokedSessions(ctx context.Context, req *rpc.RevokedSessionsRequest) (*rpc.RevokedSessionsResponse, error) {
+	resp := rpc.NewRevokedSessionsResponse()
+	ids, err := s.sessions.RevokedSessions(ctx, time.Now().Add(-s.tokens.ttl).UnixMicro())
+	if err != nil {
+		resp.Code, resp.Msg = codeUnavailable, err.Error()
+		return resp, nil
+	}
+	resp.Code, resp.Msg = 0, "success"
+	resp.Sessions = ids
+	return resp, nil
+}
+
+// session returns the live session of refreshToken and the hash of the
+// secret of the token, which callers check against the session. It returns
+// a non-zero code and a message if there is no such session.
+func (s *AuthServiceImpl) session(ctx context.Context, refreshToken string) (*session, []byte, int32, string) {
+	id, secret, ok := strings.Cut(refreshToken, ".")
+	b, err := base64.RawURLEncoding.DecodeString(secret)
+	if !ok || err != nil {
+		return nil, nil, codeUnauthenticated, "invalid refresh token"
+	}
+	sess, err := s.sessions.Session(ctx, id)
+	if errors.Is(err, errSessionNotFound) {
+		return nil, nil, codeUnauthenticated, "invalid refresh token"
+	} else if err != nil {
+		return nil, nil, codeUnavailable, err.Error()
+	}
+	if sess.Revoked {
+		return nil, nil, codeUnauthenticated, "session is revoked"
+	} else if sess.ExpireTime <= time.Now().UnixMicro() {
+		return nil, nil, codeUnauthenticated, "refresh token is expired"
+	}
+	hash := sha256.Sum256(b)
+	return sess, hash[:], 0, ""
+}
+
+// revokeReused revokes the session with ID id, whose refresh token was
+// presented again, and returns the code and message of the rejection.
+func (s *AuthServiceImpl) revokeReused(ctx context.Context, id string) (int32, string) {
+	if err := s.sessions.RevokeSession(ctx, id, time.Now().UnixMicro()); err != nil {
+		return codeUnavailable, err.Error()
+	}
+	return codeUnauthenticated, "refresh token was already used, the session is revoked"
+}
+
+// newTokens returns the tokens of the session with ID sid of user, whose
+// refresh token has secret.
+func (s *AuthServiceImpl) newTokens(user, sid string, secret []byte, now time.Time) (*rpc.Tokens, error) {
+	access, err := s.tokens.issue(user, sid, now)
+	if err != nil {
+		return nil, err
+	}
+	return &rpc.Tokens{
+		AccessToken:  access,
+		RefreshToken: sid + "." + base64.RawURLEncoding.EncodeToString(secret),
+		ExpiresIn:    int64(s.tokens.ttl / time.Second),
+	}, nil
+}
+
+// newSessionID returns a random session ID.
+func newSessionID() (string, error) {
+	b := make([]byte, 16)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(b), nil
+}
+
+// newRefreshSecret returns the random secret of a refresh token and its
+// hash. Only hashes are stored, so that leaked sessions cannot be refreshed.
+func newRefreshSecret() ([]byte, []byte, error) {
+	secret := make([]byte, 32)
+	if _, err := rand.Read(secret); err != nil {
+		return nil, nil, err
+	}
+	hash := sha256.Sum256(secret)
+	return secret, hash[:], nil
+}
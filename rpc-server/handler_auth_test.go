@@ -0,0 +1,137 @@
+package main
+
+import (
+	"context"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/TikTokTechImmersion/assignment_demo_2023/rpc-server/kitex_gen/rpc"
+	"github.com/stretchr/testify/assert"
+)
+
+func newTestAuthService(t *testing.T) *AuthServiceImpl {
+	dir := t.TempDir()
+	users, err := OpenFileUserStore(filepath.Join(dir, "users.log"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { users.Close() })
+	sessions, err := OpenFileSessionStore(filepath.Join(dir, "sessions.log"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { sessions.Close() })
+	key, err := loadTokenKey(filepath.Join(dir, "auth-key.pem"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	s, err := NewAuthServiceImpl(users, sessions, newTokenIssuer(key, "", time.Minute), time.Hour)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return s
+}
+
+func TestAuthServiceImpl_Register(t *testing.T) {
+	ctx := context.Background()
+	s := newTestAuthService(t)
+
+	tests := []struct {
+		name string
+		req  *rpc.RegisterRequest
+		code int32
+	}{
+		{name: "no user", req: &rpc.RegisterRequest{Password: "password"}, code: 400},
+		{name: "invalid user", req: &rpc.RegisterRequest{User: "a:b", Password: "password"}, code: 400},
+		{name: "long user", req: &rpc.RegisterRequest{User: strings.Repeat("a", maxUserNameLen+1), Password: "password"}, code: 400},
+		{name: "short password", req: &rpc.RegisterRequest{User: "john", Password: "short"}, code: 400},
+		{name: "long password", req: &rpc.RegisterRequest{User: "john", Password: strings.Repeat("p", maxPasswordLen+1)}, code: 400},
+		{name: "success", req: &rpc.RegisterRequest{User: "john", Password: "password"}, code: 0},
+		{name: "registered", req: &rpc.RegisterRequest{User: "john", Password: "password"}, code: 409},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resp, err := s.Register(ctx, tt.req)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.code, resp.Code, resp.Msg)
+		})
+	}
+}
+
+func TestAuthServiceImpl_Sessions(t *testing.T) {
+	ctx := context.Background()
+	s := newTestAuthService(t)
+	reg, err := s.Register(ctx, &rpc.RegisterRequest{User: "john", Password: "password"})
+	assert.NoError(t, err)
+	assert.Equal(t, int32(0), reg.Code)
+
+	tests := []struct {
+		name string
+		req  *rpc.LoginRequest
+		code int32
+	}{
+		{name: "unknown user", req: &rpc.LoginRequest{User: "doe", Password: "password"}, code: 401},
+		{name: "wrong password", req: &rpc.LoginRequest{User: "john", Password: "passw0rd"}, code: 401},
+		{name: "success", req: &rpc.LoginRequest{User: "john", Password: "password"}, code: 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resp, err := s.Login(ctx, tt.req)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.code, resp.Code, resp.Msg)
+		})
+	}
+
+	login, err := s.Login(ctx, &rpc.LoginRequest{User: "john", Password: "password"})
+	assert.NoError(t, err)
+	first := login.Tokens.RefreshToken
+	assert.NotEmpty(t, login.Tokens.AccessToken)
+	assert.Equal(t, int64(60), login.Tokens.ExpiresIn)
+
+	refreshed, err := s.RefreshToken(ctx, &rpc.RefreshTokenRequest{RefreshToken: first})
+	assert.NoError(t, err)
+	assert.Equal(t, int32(0), refreshed.Code, refreshed.Msg)
+	second := refreshed.Tokens.RefreshToken
+	assert.NotEqual(t, first, second)
+
+	// Presenting a refresh token again revokes its session.
+	reused, err := s.RefreshToken(ctx, &rpc.RefreshTokenRequest{RefreshToken: first})
+	assert.NoError(t, err)
+	assert.Equal(t, int32(401), reused.Code)
+	revoked, err := s.RefreshToken(ctx, &rpc.RefreshTokenRequest{RefreshToken: second})
+	assert.NoError(t, err)
+	assert.Equal(t, int32(401), revoked.Code)
+
+	// Logging out revokes the session too, other sessions live on.
+	other, err := s.Login(ctx, &rpc.LoginRequest{User: "john", Password: "password"})
+	assert.NoError(t, err)
+	logout, err := s.Logout(ctx, &rpc.LogoutRequest{RefreshToken: login.Tokens.RefreshToken + "x"})
+	assert.NoError(t, err)
+	assert.Equal(t, int32(401), logout.Code)
+	logout, err = s.Logout(ctx, &rpc.LogoutRequest{RefreshToken: other.Tokens.RefreshToken})
+	assert.NoError(t, err)
+	assert.Equal(t, int32(0), logout.Code)
+	refreshed, err = s.RefreshToken(ctx, &rpc.RefreshTokenRequest{RefreshToken: other.Tokens.RefreshToken})
+	assert.NoError(t, err)
+	assert.Equal(t, int32(401), refreshed.Code)
+
+	// The access tokens of revoked sessions are rejected by the frontends.
+	sid := func(refreshToken string) string {
+		id, _, _ := strings.Cut(refreshToken, ".")
+		return id
+	}
+	revokedSessions, err := s.RevokedSessions(ctx, rpc.NewRevokedSessionsRequest())
+	assert.NoError(t, err)
+	assert.Equal(t, int32(0), revokedSessions.Code)
+	assert.Equal(t, []string{sid(first), sid(other.Tokens.RefreshToken)}, revokedSessions.Sessions)
+
+	invalid, err := s.RefreshToken(ctx, &rpc.RefreshTokenRequest{RefreshToken: "garbage"})
+	assert.NoError(t, err)
+	assert.Equal(t, int32(401), invalid.Code)
+
+	keySet, err := s.GetKeySet(ctx, rpc.NewGetKeySetRequest())
+	assert.NoError(t, err)
+	assert.Contains(t, keySet.GetKeySet(), s.tokens.kid)
+}
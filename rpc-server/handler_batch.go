@@ -0,0 +1,90 @@
+package main
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/TikTokTechImmersion/assignment_demo_2023/rpc-server/kitex_gen/rpc"
+)
+
+// maxBatchSize bounds the number of messages of a BatchSend and the number of
+// chats of a MultiPull.
+const maxBatchSize = 1000
+
+func (s *IMServiceImpl) BatchSend(ctx context.Context, req *rpc.BatchSendRequest) (*rpc.BatchSendResponse, error) {
+	resp := rpc.NewBatchSendResponse()
+	if len(req.Requests) == 0 {
+		resp.Code, resp.Msg = codeInvalidArgument, "at least one message is required"
+		return resp, nil
+	} else if len(req.Requests) > maxBatchSize {
+		resp.Code, resp.Msg = codeInvalidArgument, fmt.Sprintf("at most %d messages can be sent at once", maxBatchSize)
+		return resp, nil
+	}
+	resp.Code, resp.Msg = 0, "success"
+	resp.Responses = s.sendBatch(ctx, req.Requests)
+	return resp, nil
+}
+
+func (s *IMServiceImpl) MultiPull(ctx context.Context, req *rpc.MultiPullRequest) (*rpc.MultiPullResponse, error) {
+	resp := rpc.NewMultiPullResponse()
+	if len(req.Chats) == 0 {
+		resp.Code, resp.Msg = codeInvalidArgument, "at least one chat is required"
+		return resp, nil
+	} else if len(req.Chats) > maxBatchSize {
+		resp.Code, resp.Msg = codeInvalidArgument, fmt.Sprintf("at most %d chats can be pulled at once", maxBatchSize)
+		return resp, nil
+	}
+	// The pull of every chat takes a token of its own from the bucket of
+	// the chat, but the user takes one for all of them.
+	if wait, why := s.limits.pull(ctx, req.User, ""); wait > 0 {
+		resp.Code, resp.Msg, resp.RetryAfterMs = codeRateLimited, why, retryAfterMs(wait)
+		return resp, nil
+	}
+	limit := int(req.GetLimit())
+	if limit <= 0 {
+		limit = defaultPullLimit
+	}
+
+	// Chats rejected on their own get an error response, the others are read
+	// together.
+	results := make([]*rpc.PullResponse, 0, len(req.Chats))
+	var (
+		queries []RangeQuery
+		pulled  []*rpc.PullResponse
+	)
+	for _, c := range req.Chats {
+		result := rpc.NewPullResponse()
+		results = append(results, result)
+		cursor, code, msg := s.resolveCursor(ctx, req.User, c)
+		if code != 0 {
+			result.Code, result.Msg = code, msg
+			continue
+		}
+		if wait, why := s.limits.pull(ctx, "", cursor.Chat); wait > 0 {
+			result.Code, result.Msg, result.RetryAfterMs = codeRateLimited, why, retryAfterMs(wait)
+			continue
+		}
+		queries = append(queries, RangeQuery{Chat: cursor.Chat, Cursor: cursor.Cursor, Limit: limit, Reverse: req.GetReverse()})
+		pulled = append(pulled, result)
+	}
+	if len(queries) > 0 {
+		pages, err := s.pullPages(ctx, queries)
+		if err != nil {
+			resp.Code, resp.Msg = codeUnavailable, err.Error()
+			return resp, nil
+		}
+		for i, p := range pages {
+			result, hasMore := pulled[i], p.hasMore
+			result.Code, result.Msg = 0, "success"
+			result.Messages = p.msgs
+			result.HasMore = &hasMore
+			if hasMore {
+				nextCursor := p.nextCursor
+				result.NextCursor = &nextCursor
+			}
+		}
+	}
+	resp.Code, resp.Msg = 0, "success"
+	resp.Responses = results
+	return resp, nil
+}
@@ -0,0 +1,95 @@
+package main
+
+import (
+	"context"
+	"testing"
+
+	"github.com/TikTokTechImmersion/assignment_demo_2023/rpc-server/kitex_gen/rpc"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIMServiceImpl_BatchSend(t *testing.T) {
+	ctx := context.Background()
+	s := newTestService(t)
+	key := "k"
+	resp, err := s.BatchSend(ctx, &rpc.BatchSendRequest{Requests: []*rpc.SendRequest{
+		{Message: &rpc.Message{Chat: "doe:john", Text: "1", Sender: "john"}},
+		{Message: &rpc.Message{Chat: "john:", Text: "2", Sender: "john"}},
+		{Message: &rpc.Message{Chat: "doe:john", Text: "3", Sender: "jane"}},
+		{Message: &rpc.Message{Chat: "john:doe", Text: "4", Sender: "doe"}, IdempotencyKey: &key},
+		{Message: &rpc.Message{Chat: "doe:john", Text: "4", Sender: "doe"}, IdempotencyKey: &key},
+		{},
+		{Message: &rpc.Message{Chat: "jane:john", Text: "5", Sender: "john"}},
+	}})
+	assert.NoError(t, err)
+	assert.Equal(t, int32(0), resp.Code)
+	var codes, ids []int64
+	for _, r := range resp.Responses {
+		codes = append(codes, int64(r.Code))
+		ids = append(ids, r.GetID())
+	}
+	assert.Equal(t, []int64{0, 400, 403, 0, 0, 400, 0}, codes)
+	assert.Equal(t, []int64{1, 0, 0, 2, 2, 0, 1}, ids)
+
+	// Retrying the batch returns the original message of the keyed request.
+	retry, err := s.Send(ctx, &rpc.SendRequest{
+		Message:        &rpc.Message{Chat: "doe:john", Text: "4", Sender: "doe"},
+		IdempotencyKey: &key,
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, int64(2), retry.GetID())
+
+	user := "john"
+	pull, err := s.Pull(ctx, &rpc.PullRequest{Chat: "doe:john", User: &user})
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"1", "4"}, texts(pull.Messages))
+
+	resp, err = s.BatchSend(ctx, &rpc.BatchSendRequest{})
+	assert.NoError(t, err)
+	assert.Equal(t, int32(400), resp.Code)
+}
+
+func TestIMServiceImpl_MultiPull(t *testing.T) {
+	ctx := context.Background()
+	s := newTestService(t)
+	var reqs []*rpc.SendRequest
+	for _, msg := range []*rpc.Message{
+		{Chat: "a:john", Text: "a1", Sender: "john"},
+		{Chat: "a:john", Text: "a2", Sender: "john"},
+		{Chat: "a:john", Text: "a3", Sender: "john"},
+		{Chat: "b:john", Text: "b1", Sender: "john"},
+		{Chat: "a:b", Text: "x", Sender: "a"},
+	} {
+		reqs = append(reqs, &rpc.SendRequest{Message: msg})
+	}
+	_, err := s.BatchSend(ctx, &rpc.BatchSendRequest{Requests: reqs})
+	assert.NoError(t, err)
+
+	limit := int32(2)
+	resp, err := s.MultiPull(ctx, &rpc.MultiPullRequest{
+		User:  "john",
+		Chats: []*rpc.ChatCursor{{Chat: "john:a"}, {Chat: "a:b"}, {Chat: "b:john"}},
+		Limit: &limit,
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, int32(0), resp.Code)
+	assert.Len(t, resp.Responses, 3)
+	a, ab, b := resp.Responses[0], resp.Responses[1], resp.Responses[2]
+	assert.Equal(t, int32(0), a.Code)
+	assert.Equal(t, []string{"a1", "a2"}, texts(a.Messages))
+	assert.True(t, a.GetHasMore())
+	assert.Equal(t, int32(403), ab.Code)
+	assert.Equal(t, []string{"b1"}, texts(b.Messages))
+	assert.False(t, b.GetHasMore())
+
+	resp, err = s.MultiPull(ctx, &rpc.MultiPullRequest{
+		User:  "john",
+		Chats: []*rpc.ChatCursor{{Chat: "a:john", Cursor: a.GetNextCursor()}},
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"a3"}, texts(resp.Responses[0].Messages))
+
+	resp, err = s.MultiPull(ctx, &rpc.MultiPullRequest{User: "john"})
+	assert.NoError(t, err)
+	assert.Equal(t, int32(400), resp.Code)
+}
@@ -0,0 +1,67 @@
+package main
+
+import (
+	"context"
+	"time"
+
+	"github.com/TikTokTechImmersion/assignment_demo_2023/rpc-server/kitex_gen/rpc"
+)
+
+const (
+	// defaultReleasedBlobsLimit and maxReleasedBlobsLimit bound
+	// ReleasedBlobsRequest.Limit.
+	defaultReleasedBlobsLimit = 100
+	maxReleasedBlobsLimit     = 1000
+	// blobAttachTimeout is how long the blobs uploaded to the http-server
+	// are kept without any message attaching them.
+	blobAttachTimeout = 24 * time.Hour
+)
+
+// RegisterBlob records a blob about to be uploaded to the http-server, which
+// is released unless a message attaches it within blobAttachTimeout, so that
+// the blobs of abandoned uploads are deleted too.
+func (s *IMServiceImpl) RegisterBlob(ctx context.Context, req *rpc.RegisterBlobRequest) (*rpc.RegisterBlobResponse, error) {
+	resp := rpc.NewRegisterBlobResponse()
+	if req.Key == "" {
+		resp.Code, resp.Msg = codeInvalidArgument, "blob key is empty"
+		return resp, nil
+	}
+	deadline := time.Now().Add(blobAttachTimeout).UnixMicro()
+	if err := s.blobs.Register(ctx, req.Key, deadline); err != nil {
+		resp.Code, resp.Msg = codeUnavailable, err.Error()
+		return resp, nil
+	}
+	resp.Code, resp.Msg = 0, "success"
+	return resp, nil
+}
+
+// ReleasedBlobs returns the keys of the blobs no message is attached to
+// anymore, or registered and attached too late, for the http-servers to delete
+// them. The cursor of the request acknowledges the keys before it for the
+// consumer, so every http-server asks for the next keys once it deleted the
+// blobs, and resumes from there after a restart.
+func (s *IMServiceImpl) ReleasedBlobs(ctx context.Context, req *rpc.ReleasedBlobsRequest) (*rpc.ReleasedBlobsResponse, error) {
+	resp := rpc.NewReleasedBlobsResponse()
+	if req.Consumer == "" {
+		resp.Code, resp.Msg = codeInvalidArgument, "consumer is empty"
+		return resp, nil
+	}
+	limit := int(req.Limit)
+	if limit <= 0 {
+		limit = defaultReleasedBlobsLimit
+	} else if limit > maxReleasedBlobsLimit {
+		limit = maxReleasedBlobsLimit
+	}
+	if err := s.blobs.Expire(ctx, time.Now().UnixMicro()); err != nil {
+		resp.Code, resp.Msg = codeUnavailable, err.Error()
+		return resp, nil
+	}
+	keys, next, err := s.blobs.Released(ctx, req.Consumer, req.Cursor, limit)
+	if err != nil {
+		resp.Code, resp.Msg = codeUnavailable, err.Error()
+		return resp, nil
+	}
+	resp.Code, resp.Msg = 0, "success"
+	resp.Keys, resp.NextCursor = keys, &next
+	return resp, nil
+}
@@ -0,0 +1,61 @@
+package main
+
+import (
+	"context"
+	"testing"
+
+	"github.com/TikTokTechImmersion/assignment_demo_2023/rpc-server/kitex_gen/rpc"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIMServiceImpl_ReleasedBlobs(t *testing.T) {
+	ctx := context.Background()
+	s := newTestService(t)
+	send := func(key string) int64 {
+		resp, err := s.Send(ctx, &rpc.SendRequest{Message: &rpc.Message{
+			Chat: "doe:john", Sender: "doe", Type: rpc.ContentType_FILE, Attachment: &rpc.Attachment{Key: key, Name: key},
+		}})
+		assert.NoError(t, err)
+		assert.Equal(t, int32(0), resp.Code)
+		return resp.GetID()
+	}
+	remove := func(id int64) {
+		resp, err := s.DeleteMessage(ctx, &rpc.DeleteMessageRequest{Chat: "doe:john", ID: id, User: "doe"})
+		assert.NoError(t, err)
+		assert.Equal(t, int32(0), resp.Code)
+	}
+	released := func(cursor int64) *rpc.ReleasedBlobsResponse {
+		resp, err := s.ReleasedBlobs(ctx, &rpc.ReleasedBlobsRequest{Cursor: cursor, Consumer: "h1"})
+		assert.NoError(t, err)
+		assert.Equal(t, int32(0), resp.Code)
+		return resp
+	}
+	register, err := s.RegisterBlob(ctx, &rpc.RegisterBlobRequest{Key: "k1"})
+	assert.NoError(t, err)
+	assert.Equal(t, int32(0), register.Code)
+	assert.Contains(t, s.blobs.(*fileBlobRefStore).pending, "k1")
+	first, second := send("k1"), send("k1")
+	send("k2")
+	// Attached blobs are no longer released at their deadline.
+	assert.NotContains(t, s.blobs.(*fileBlobRefStore).pending, "k1")
+
+	// A blob sent twice is released along with the last of its messages.
+	remove(first)
+	assert.Empty(t, released(0).Keys)
+	remove(second)
+	resp := released(0)
+	assert.Equal(t, []string{"k1"}, resp.Keys)
+	assert.Equal(t, int64(1), resp.GetNextCursor())
+
+	resp = released(resp.GetNextCursor())
+	assert.Empty(t, resp.Keys)
+	assert.Equal(t, int64(1), resp.GetNextCursor())
+	assert.Empty(t, released(0).Keys)
+
+	register, err = s.RegisterBlob(ctx, &rpc.RegisterBlobRequest{})
+	assert.NoError(t, err)
+	assert.Equal(t, int32(400), register.Code)
+	resp, err = s.ReleasedBlobs(ctx, &rpc.ReleasedBlobsRequest{})
+	assert.NoError(t, err)
+	assert.Equal(t, int32(400), resp.Code)
+}
@@ -0,0 +1,101 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/TikTokTechImmersion/assignment_demo_2023/rpc-server/kitex_gen/rpc"
+	"github.com/cloudwego/kitex/pkg/klog"
+)
+
+func (s *IMServiceImpl) EditMessage(ctx context.Context, req *rpc.EditMessageRequest) (*rpc.EditMessageResponse, error) {
+	resp := rpc.NewEditMessageResponse()
+	msg, code, errMsg := s.updateMessage(ctx, req.Chat, req.ID, req.User, func(msg *rpc.Message) {
+		msg.Text, msg.Edited = req.Text, true
+	})
+	resp.Code, resp.Msg = code, errMsg
+	if code == 0 {
+		resp.Message = msg
+	}
+	return resp, nil
+}
+
+func (s *IMServiceImpl) DeleteMessage(ctx context.Context, req *rpc.DeleteMessageRequest) (*rpc.DeleteMessageResponse, error) {
+	resp := rpc.NewDeleteMessageResponse()
+	var attachment *rpc.Attachment
+	_, resp.Code, resp.Msg = s.updateMessage(ctx, req.Chat, req.ID, req.User, func(msg *rpc.Message) {
+		attachment = msg.Attachment
+		msg.Text, msg.Attachment, msg.Deleted = "", nil, true
+	})
+	if resp.Code == 0 && attachment != nil {
+		if err := s.blobs.Detach(ctx, attachment.Key); err != nil {
+			klog.CtxErrorf(ctx, "failed to detach blob %q of message %d of chat %q: %v", attachment.Key, req.ID, req.Chat, err)
+		}
+	}
+	return resp, nil
+}
+
+// updateMessage applies change to the message with ID id, which must have
+// been sent by user and not deleted yet, and stores the new version. It
+// returns a non-zero code and a message if the update is rejected.
+func (s *IMServiceImpl) updateMessage(ctx context.Context, chat string, id int64, user string,
+	change func(msg *rpc.Message)) (*rpc.Message, int32, string) {
+	chat, members, err := s.resolveChat(ctx, chat)
+	if err != nil {
+		return nil, errorCode(err), err.Error()
+	}
+	if !containsString(members, user) {
+		return nil, codePermissionDenied, fmt.Sprintf("user %q is not a member of chat %q", user, chat)
+	}
+	// Like send times, update times are assigned by the server. The sender is
+	// checked by the store along with the update, so that concurrent edits
+	// and deletes are ordered.
+	msg, err := s.store.Update(ctx, chat, id, time.Now().UnixMicro(), func(msg *rpc.Message) error {
+		if msg.Sender != user {
+			return fmt.Errorf("%w: message %d was not sent by %q", errPermissionDenied, id, user)
+		}
+		change(msg)
+		return nil
+	})
+	if err != nil {
+		return nil, errorCode(err), err.Error()
+	}
+	s.notifier.notify(chat)
+	// The message is updated already, see sendBatch.
+	if err := s.inbox.Update(ctx, msg, members); err != nil {
+		klog.CtxErrorf(ctx, "failed to update message %d of chat %q in inboxes: %v", id, chat, err)
+	}
+	if err := s.search.Index(ctx, msg); err != nil {
+		klog.CtxErrorf(ctx, "failed to index message %d of chat %q: %v", id, chat, err)
+	}
+	return msg, 0, "success"
+}
+
+func (s *IMServiceImpl) MessageHistory(ctx context.Context, req *rpc.MessageHistoryRequest) (*rpc.MessageHistoryResponse, error) {
+	resp := rpc.NewMessageHistoryResponse()
+	chat, members, err := s.resolveChat(ctx, req.Chat)
+	if err != nil {
+		resp.Code, resp.Msg = errorCode(err), err.Error()
+		return resp, nil
+	}
+	if !containsString(members, req.User) {
+		resp.Code, resp.Msg = codePermissionDenied, fmt.Sprintf("user %q is not a member of chat %q", req.User, req.Chat)
+		return resp, nil
+	}
+	// The history is read first, so that the current version is at least as
+	// recent as the history.
+	history, err := s.store.History(ctx, chat, req.ID)
+	if err != nil {
+		resp.Code, resp.Msg = errorCode(err), err.Error()
+		return resp, nil
+	}
+	current, err := s.store.Get(ctx, chat, req.ID)
+	if err != nil {
+		resp.Code, resp.Msg = errorCode(err), err.Error()
+		return resp, nil
+	}
+	resp.Code, resp.Msg = 0, "success"
+	resp.Messages = append(history, current)
+	return resp, nil
+}
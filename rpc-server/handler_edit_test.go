@@ -0,0 +1,115 @@
+package main
+
+import (
+	"context"
+	"testing"
+
+	"github.com/TikTokTechImmersion/assignment_demo_2023/rpc-server/kitex_gen/rpc"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIMServiceImpl_EditMessage(t *testing.T) {
+	ctx := context.Background()
+	s := newTestService(t)
+	user := "john"
+	for _, text := range []string{"1", "2"} {
+		_, err := s.Send(ctx, &rpc.SendRequest{Message: &rpc.Message{Chat: "doe:john", Text: text, Sender: "doe"}})
+		assert.NoError(t, err)
+	}
+
+	tests := []struct {
+		name string
+		req  *rpc.EditMessageRequest
+		code int32
+	}{
+		{name: "not the sender", req: &rpc.EditMessageRequest{Chat: "doe:john", ID: 1, User: "john", Text: "x"}, code: 403},
+		{name: "not a member", req: &rpc.EditMessageRequest{Chat: "doe:john", ID: 1, User: "jane", Text: "x"}, code: 403},
+		{name: "unknown message", req: &rpc.EditMessageRequest{Chat: "doe:john", ID: 3, User: "doe", Text: "x"}, code: 404},
+		{name: "invalid chat", req: &rpc.EditMessageRequest{Chat: "doe:", ID: 1, User: "doe", Text: "x"}, code: 400},
+		{name: "success", req: &rpc.EditMessageRequest{Chat: "john:doe", ID: 1, User: "doe", Text: "1b"}, code: 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resp, err := s.EditMessage(ctx, tt.req)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.code, resp.Code, resp.Msg)
+		})
+	}
+
+	resp, err := s.Pull(ctx, &rpc.PullRequest{Chat: "doe:john", User: &user})
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"1b", "2"}, texts(resp.Messages))
+	assert.True(t, resp.Messages[0].Edited)
+	assert.Greater(t, resp.Messages[0].UpdateTime, resp.Messages[1].SendTime)
+
+	history, err := s.MessageHistory(ctx, &rpc.MessageHistoryRequest{Chat: "doe:john", ID: 1, User: "john"})
+	assert.NoError(t, err)
+	assert.Equal(t, int32(0), history.Code)
+	assert.Equal(t, []string{"1", "1b"}, texts(history.Messages))
+	history, err = s.MessageHistory(ctx, &rpc.MessageHistoryRequest{Chat: "doe:john", ID: 1, User: "jane"})
+	assert.NoError(t, err)
+	assert.Equal(t, int32(403), history.Code)
+}
+
+func TestIMServiceImpl_DeleteMessage(t *testing.T) {
+	ctx := context.Background()
+	s := newTestService(t)
+	user := "john"
+	for _, text := range []string{"1", "2"} {
+		_, err := s.Send(ctx, &rpc.SendRequest{Message: &rpc.Message{Chat: "doe:john", Text: text, Sender: "doe"}})
+		assert.NoError(t, err)
+	}
+
+	resp, err := s.DeleteMessage(ctx, &rpc.DeleteMessageRequest{Chat: "doe:john", ID: 2, User: "john"})
+	assert.NoError(t, err)
+	assert.Equal(t, int32(403), resp.Code)
+	resp, err = s.DeleteMessage(ctx, &rpc.DeleteMessageRequest{Chat: "doe:john", ID: 2, User: "doe"})
+	assert.NoError(t, err)
+	assert.Equal(t, int32(0), resp.Code)
+	resp, err = s.DeleteMessage(ctx, &rpc.DeleteMessageRequest{Chat: "doe:john", ID: 2, User: "doe"})
+	assert.NoError(t, err)
+	assert.Equal(t, int32(410), resp.Code)
+	edit, err := s.EditMessage(ctx, &rpc.EditMessageRequest{Chat: "doe:john", ID: 2, User: "doe", Text: "x"})
+	assert.NoError(t, err)
+	assert.Equal(t, int32(410), edit.Code)
+
+	// The tombstone stays in place, and is no longer unread.
+	pull, err := s.Pull(ctx, &rpc.PullRequest{Chat: "doe:john", User: &user})
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"1", ""}, texts(pull.Messages))
+	assert.True(t, pull.Messages[1].Deleted)
+	chats, err := s.ListChats(ctx, &rpc.ListChatsRequest{User: "john"})
+	assert.NoError(t, err)
+	assert.True(t, chats.Chats[0].LastMessage.Deleted)
+	assert.Equal(t, int64(1), chats.Chats[0].UnreadCount)
+}
+
+func TestIMServiceImpl_PullChanges(t *testing.T) {
+	ctx := context.Background()
+	s := newTestService(t)
+	user := "john"
+	var sendTimes []int64
+	for _, text := range []string{"1", "2", "3"} {
+		resp, err := s.Send(ctx, &rpc.SendRequest{Message: &rpc.Message{Chat: "doe:john", Text: text, Sender: "doe"}})
+		assert.NoError(t, err)
+		sendTimes = append(sendTimes, resp.GetSendTime())
+	}
+	_, err := s.EditMessage(ctx, &rpc.EditMessageRequest{Chat: "doe:john", ID: 1, User: "doe", Text: "1b"})
+	assert.NoError(t, err)
+
+	changes := true
+	resp, err := s.Pull(ctx, &rpc.PullRequest{Chat: "doe:john", User: &user, Cursor: sendTimes[1], Limit: 2, Changes: &changes})
+	assert.NoError(t, err)
+	assert.Equal(t, int32(0), resp.Code)
+	assert.Equal(t, []string{"2", "3"}, texts(resp.Messages))
+	assert.True(t, resp.GetHasMore())
+	resp, err = s.Pull(ctx, &rpc.PullRequest{Chat: "doe:john", User: &user, Cursor: resp.GetNextCursor(), Changes: &changes})
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"1b"}, texts(resp.Messages))
+	assert.False(t, resp.GetHasMore())
+
+	reverse := true
+	resp, err = s.Pull(ctx, &rpc.PullRequest{Chat: "doe:john", User: &user, Reverse: &reverse, Changes: &changes})
+	assert.NoError(t, err)
+	assert.Equal(t, int32(400), resp.Code)
+}
@@ -0,0 +1,102 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/TikTokTechImmersion/assignment_demo_2023/rpc-server/kitex_gen/rpc"
+)
+
+func (s *IMServiceImpl) ListChats(ctx context.Context, req *rpc.ListChatsRequest) (*rpc.ListChatsResponse, error) {
+	resp := rpc.NewListChatsResponse()
+	limit := int(req.Limit)
+	if limit <= 0 {
+		limit = defaultPullLimit
+	}
+	entries, err := s.inbox.List(ctx, req.User, req.Cursor, limit+1)
+	if err != nil {
+		resp.Code, resp.Msg = codeUnavailable, err.Error()
+		return resp, nil
+	}
+	hasMore := len(entries) > limit
+	if hasMore {
+		resp.NextCursor = &entries[limit].ActiveTime
+		entries = entries[:limit]
+	}
+	chats := make([]*rpc.ChatSummary, 0, len(entries))
+	now := time.Now().UnixMicro()
+	for _, e := range entries {
+		// Inboxes only keep the IDs of the last messages, read from the
+		// MessageStore for their previews.
+		var last *rpc.Message
+		if e.LastID != 0 {
+			last, err = s.store.Get(ctx, e.Chat, e.LastID)
+			if errors.Is(err, errMessageNotFound) {
+				// Purged after the inbox was listed.
+				last = nil
+			} else if err != nil {
+				resp.Code, resp.Msg = codeUnavailable, err.Error()
+				return resp, nil
+			}
+		}
+		if last != nil {
+			// Inboxes keep the last messages of chats past their expiry.
+			q, err := purgeQuery(ctx, s.store, s.retention, e.Chat, now)
+			if err != nil {
+				resp.Code, resp.Msg = codeUnavailable, err.Error()
+				return resp, nil
+			}
+			if q.expired(last) {
+				last = nil
+			}
+		}
+		chats = append(chats, &rpc.ChatSummary{
+			Chat:        e.Chat,
+			ActiveTime:  e.ActiveTime,
+			LastMessage: last,
+			UnreadCount: e.Unread,
+		})
+	}
+	resp.Code, resp.Msg = 0, "success"
+	resp.Chats = chats
+	resp.HasMore = &hasMore
+	return resp, nil
+}
+
+func (s *IMServiceImpl) MarkRead(ctx context.Context, req *rpc.MarkReadRequest) (*rpc.MarkReadResponse, error) {
+	resp := rpc.NewMarkReadResponse()
+	chat, members, err := s.resolveChat(ctx, req.Chat)
+	if err != nil {
+		resp.Code, resp.Msg = errorCode(err), err.Error()
+		return resp, nil
+	}
+	if !containsString(members, req.User) {
+		resp.Code, resp.Msg = codePermissionDenied, fmt.Sprintf("user %q is not a member of chat %q", req.User, req.Chat)
+		return resp, nil
+	}
+	if _, err := s.store.Get(ctx, chat, req.MessageID); err != nil {
+		resp.Code, resp.Msg = errorCode(err), err.Error()
+		return resp, nil
+	}
+	if err := s.inbox.MarkRead(ctx, chat, req.User, req.MessageID); err != nil {
+		resp.Code, resp.Msg = codeUnavailable, err.Error()
+		return resp, nil
+	}
+	resp.Code, resp.Msg = 0, "success"
+	return resp, nil
+}
+
+// readPositions returns the read position of every member of chat.
+func (s *IMServiceImpl) readPositions(ctx context.Context, chat string, members []string) ([]*rpc.ReadPosition, error) {
+	ids, err := s.inbox.ReadPositions(ctx, chat, members)
+	if err != nil {
+		return nil, err
+	}
+	positions := make([]*rpc.ReadPosition, 0, len(members))
+	for i, user := range members {
+		positions = append(positions, &rpc.ReadPosition{User: user, MessageID: ids[i]})
+	}
+	return positions, nil
+}
@@ -0,0 +1,86 @@
+package main
+
+import (
+	"context"
+	"testing"
+
+	"github.com/TikTokTechImmersion/assignment_demo_2023/rpc-server/kitex_gen/rpc"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIMServiceImpl_ListChats(t *testing.T) {
+	ctx := context.Background()
+	s := newTestService(t)
+	send := func(chat, sender, text string) {
+		resp, err := s.Send(ctx, &rpc.SendRequest{Message: &rpc.Message{Chat: chat, Text: text, Sender: sender}})
+		assert.NoError(t, err)
+		assert.Equal(t, int32(0), resp.Code)
+	}
+	send("doe:john", "doe", "1")
+	send("jane:john", "jane", "2")
+	created, err := s.CreateChat(ctx, &rpc.CreateChatRequest{Creator: "jane", Members: []string{"john"}})
+	assert.NoError(t, err)
+	send("doe:john", "john", "3")
+	send("doe:john", "doe", "4")
+
+	resp, err := s.ListChats(ctx, &rpc.ListChatsRequest{User: "john", Limit: 2})
+	assert.NoError(t, err)
+	assert.Equal(t, int32(0), resp.Code)
+	assert.Len(t, resp.Chats, 2)
+	assert.Equal(t, "doe:john", resp.Chats[0].Chat)
+	assert.Equal(t, "4", resp.Chats[0].LastMessage.Text)
+	// Only "4" is unread, john read "1" when sending "3".
+	assert.Equal(t, int64(1), resp.Chats[0].UnreadCount)
+	assert.Equal(t, created.GetChat(), resp.Chats[1].Chat)
+	assert.Nil(t, resp.Chats[1].LastMessage)
+	assert.True(t, resp.GetHasMore())
+
+	resp, err = s.ListChats(ctx, &rpc.ListChatsRequest{User: "john", Cursor: resp.GetNextCursor(), Limit: 2})
+	assert.NoError(t, err)
+	assert.Len(t, resp.Chats, 1)
+	assert.Equal(t, "jane:john", resp.Chats[0].Chat)
+	assert.Equal(t, int64(1), resp.Chats[0].UnreadCount)
+	assert.False(t, resp.GetHasMore())
+
+	// Members removed from a group chat no longer see it.
+	_, err = s.RemoveMembers(ctx, &rpc.RemoveMembersRequest{Chat: created.GetChat(), Operator: "jane", Members: []string{"john"}})
+	assert.NoError(t, err)
+	resp, err = s.ListChats(ctx, &rpc.ListChatsRequest{User: "john"})
+	assert.NoError(t, err)
+	assert.Len(t, resp.Chats, 2)
+}
+
+func TestIMServiceImpl_MarkRead(t *testing.T) {
+	ctx := context.Background()
+	s := newTestService(t)
+	for _, text := range []string{"1", "2", "3"} {
+		_, err := s.Send(ctx, &rpc.SendRequest{Message: &rpc.Message{Chat: "doe:john", Text: text, Sender: "doe"}})
+		assert.NoError(t, err)
+	}
+
+	tests := []struct {
+		name     string
+		req      *rpc.MarkReadRequest
+		wantCode int32
+	}{
+		{name: "success", req: &rpc.MarkReadRequest{Chat: "john:doe", User: "john", MessageID: 2}, wantCode: 0},
+		{name: "not a member", req: &rpc.MarkReadRequest{Chat: "doe:john", User: "jane", MessageID: 2}, wantCode: 403},
+		{name: "unknown message", req: &rpc.MarkReadRequest{Chat: "doe:john", User: "john", MessageID: 4}, wantCode: 404},
+		{name: "malformed chat", req: &rpc.MarkReadRequest{Chat: "john:", User: "john", MessageID: 1}, wantCode: 400},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := s.MarkRead(ctx, tt.req)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.wantCode, got.Code)
+		})
+	}
+
+	user := "john"
+	pull, err := s.Pull(ctx, &rpc.PullRequest{Chat: "doe:john", User: &user})
+	assert.NoError(t, err)
+	assert.Equal(t, []*rpc.ReadPosition{{User: "doe", MessageID: 3}, {User: "john", MessageID: 2}}, pull.ReadPositions)
+	chats, err := s.ListChats(ctx, &rpc.ListChatsRequest{User: "john"})
+	assert.NoError(t, err)
+	assert.Equal(t, int64(1), chats.Chats[0].UnreadCount)
+}
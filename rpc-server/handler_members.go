@@ -0,0 +1,90 @@
+package main
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/TikTokTechImmersion/assignment_demo_2023/rpc-server/kitex_gen/rpc"
+	"github.com/cloudwego/kitex/pkg/klog"
+)
+
+func (s *IMServiceImpl) CreateChat(ctx context.Context, req *rpc.CreateChatRequest) (*rpc.CreateChatResponse, error) {
+	resp := rpc.NewCreateChatResponse()
+	members := addMembers([]string{req.Creator}, req.Members)
+	for _, user := range members {
+		if err := validateUser(user); err != nil {
+			resp.Code, resp.Msg = codeInvalidArgument, err.Error()
+			return resp, nil
+		}
+	}
+	chat, err := newGroupChatID()
+	if err == nil {
+		err = s.members.CreateChat(ctx, chat, members)
+	}
+	if err != nil {
+		resp.Code, resp.Msg = errorCode(err), err.Error()
+		return resp, nil
+	}
+	if err := s.inbox.Join(ctx, chat, members); err != nil {
+		klog.CtxErrorf(ctx, "failed to add chat %q to inboxes: %v", chat, err)
+	}
+	resp.Code, resp.Msg = 0, "success"
+	resp.Chat = &chat
+	return resp, nil
+}
+
+func (s *IMServiceImpl) AddMembers(ctx context.Context, req *rpc.AddMembersRequest) (*rpc.AddMembersResponse, error) {
+	resp := rpc.NewAddMembersResponse()
+	resp.Code, resp.Msg = s.changeMembers(ctx, req.Chat, req.Operator, req.Members, s.members.AddMembers, s.inbox.Join)
+	return resp, nil
+}
+
+func (s *IMServiceImpl) RemoveMembers(ctx context.Context, req *rpc.RemoveMembersRequest) (*rpc.RemoveMembersResponse, error) {
+	resp := rpc.NewRemoveMembersResponse()
+	resp.Code, resp.Msg = s.changeMembers(ctx, req.Chat, req.Operator, req.Members, s.members.RemoveMembers, s.inbox.Leave)
+	return resp, nil
+}
+
+// changeMembers lets operator, a member of the group chat, change its members
+// with change, then the inboxes of users with changeInbox.
+func (s *IMServiceImpl) changeMembers(ctx context.Context, chat, operator string, users []string,
+	change, changeInbox func(ctx context.Context, chat string, members []string) error) (int32, string) {
+	if !isGroupChat(chat) {
+		return codeInvalidArgument, fmt.Sprintf("members of chat %q cannot change, it is not a group chat", chat)
+	}
+	for _, user := range users {
+		if err := validateUser(user); err != nil {
+			return codeInvalidArgument, err.Error()
+		}
+	}
+	members, err := s.members.Members(ctx, chat)
+	if err != nil {
+		return errorCode(err), err.Error()
+	}
+	if !containsString(members, operator) {
+		return codePermissionDenied, fmt.Sprintf("user %q is not a member of chat %q", operator, chat)
+	}
+	if err := change(ctx, chat, users); err != nil {
+		return errorCode(err), err.Error()
+	}
+	if err := changeInbox(ctx, chat, users); err != nil {
+		klog.CtxErrorf(ctx, "failed to update chat %q in inboxes: %v", chat, err)
+	}
+	return 0, "success"
+}
+
+func (s *IMServiceImpl) ListMembers(ctx context.Context, req *rpc.ListMembersRequest) (*rpc.ListMembersResponse, error) {
+	resp := rpc.NewListMembersResponse()
+	_, members, err := s.resolveChat(ctx, req.Chat)
+	if err != nil {
+		resp.Code, resp.Msg = errorCode(err), err.Error()
+		return resp, nil
+	}
+	if !containsString(members, req.Operator) {
+		resp.Code, resp.Msg = codePermissionDenied, fmt.Sprintf("user %q is not a member of chat %q", req.Operator, req.Chat)
+		return resp, nil
+	}
+	resp.Code, resp.Msg = 0, "success"
+	resp.Members = members
+	return resp, nil
+}
@@ -0,0 +1,100 @@
+package main
+
+import (
+	"context"
+	"testing"
+
+	"github.com/TikTokTechImmersion/assignment_demo_2023/rpc-server/kitex_gen/rpc"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIMServiceImpl_GroupChat(t *testing.T) {
+	ctx := context.Background()
+	s := newTestService(t)
+
+	created, err := s.CreateChat(ctx, &rpc.CreateChatRequest{Creator: "john", Members: []string{"doe", "john"}})
+	assert.NoError(t, err)
+	assert.Equal(t, int32(0), created.Code)
+	chat := created.GetChat()
+	assert.True(t, isGroupChat(chat))
+
+	send := func(sender string) int32 {
+		resp, err := s.Send(ctx, &rpc.SendRequest{Message: &rpc.Message{Chat: chat, Text: "hi", Sender: sender}})
+		assert.NoError(t, err)
+		return resp.Code
+	}
+	pull := func(user string) int32 {
+		resp, err := s.Pull(ctx, &rpc.PullRequest{Chat: chat, User: &user})
+		assert.NoError(t, err)
+		return resp.Code
+	}
+	list := func(operator string) []string {
+		resp, err := s.ListMembers(ctx, &rpc.ListMembersRequest{Chat: chat, Operator: operator})
+		assert.NoError(t, err)
+		return resp.Members
+	}
+
+	assert.Equal(t, []string{"john", "doe"}, list("john"))
+	assert.Equal(t, int32(0), send("doe"))
+	assert.Equal(t, int32(403), send("jane"))
+	assert.Equal(t, int32(403), pull("jane"))
+
+	added, err := s.AddMembers(ctx, &rpc.AddMembersRequest{Chat: chat, Operator: "jane", Members: []string{"jane"}})
+	assert.NoError(t, err)
+	assert.Equal(t, int32(403), added.Code, "only members can add members")
+	added, err = s.AddMembers(ctx, &rpc.AddMembersRequest{Chat: chat, Operator: "doe", Members: []string{"jane"}})
+	assert.NoError(t, err)
+	assert.Equal(t, int32(0), added.Code)
+	assert.Equal(t, []string{"john", "doe", "jane"}, list("jane"))
+	assert.Equal(t, int32(0), send("jane"))
+	assert.Equal(t, int32(0), pull("jane"))
+
+	removed, err := s.RemoveMembers(ctx, &rpc.RemoveMembersRequest{Chat: chat, Operator: "john", Members: []string{"doe"}})
+	assert.NoError(t, err)
+	assert.Equal(t, int32(0), removed.Code)
+	assert.Equal(t, []string{"john", "jane"}, list("john"))
+	assert.Equal(t, int32(403), send("doe"))
+	assert.Equal(t, int32(403), pull("doe"))
+}
+
+func TestIMServiceImpl_ChangeMembers(t *testing.T) {
+	ctx := context.Background()
+	s := newTestService(t)
+
+	tests := []struct {
+		name     string
+		req      *rpc.AddMembersRequest
+		wantCode int32
+	}{
+		{
+			name:     "direct chat",
+			req:      &rpc.AddMembersRequest{Chat: "doe:john", Operator: "john", Members: []string{"jane"}},
+			wantCode: 400,
+		},
+		{
+			name:     "invalid user",
+			req:      &rpc.AddMembersRequest{Chat: "group-0", Operator: "john", Members: []string{"a:b"}},
+			wantCode: 400,
+		},
+		{
+			name:     "unknown group chat",
+			req:      &rpc.AddMembersRequest{Chat: "group-0", Operator: "john", Members: []string{"jane"}},
+			wantCode: 404,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := s.AddMembers(ctx, tt.req)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.wantCode, got.Code)
+		})
+	}
+
+	created, err := s.CreateChat(ctx, &rpc.CreateChatRequest{Creator: "john", Members: []string{""}})
+	assert.NoError(t, err)
+	assert.Equal(t, int32(400), created.Code)
+
+	members, err := s.ListMembers(ctx, &rpc.ListMembersRequest{Chat: "john:doe", Operator: "doe"})
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"doe", "john"}, members.Members)
+}
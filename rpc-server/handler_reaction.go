@@ -0,0 +1,69 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/TikTokTechImmersion/assignment_demo_2023/rpc-server/kitex_gen/rpc"
+	"github.com/cloudwego/kitex/pkg/klog"
+)
+
+// maxEmojiLen bounds the length of the emoji of a reaction, which may be a
+// sequence of code points such as a flag or a family.
+const maxEmojiLen = 64
+
+func (s *IMServiceImpl) AddReaction(ctx context.Context, req *rpc.AddReactionRequest) (*rpc.AddReactionResponse, error) {
+	resp := rpc.NewAddReactionResponse()
+	msg, code, errMsg := s.changeReaction(ctx, req.Chat, req.ID, req.Emoji, req.User, true)
+	resp.Code, resp.Msg = code, errMsg
+	if code == 0 {
+		resp.Message = msg
+	}
+	return resp, nil
+}
+
+func (s *IMServiceImpl) RemoveReaction(ctx context.Context, req *rpc.RemoveReactionRequest) (*rpc.RemoveReactionResponse, error) {
+	resp := rpc.NewRemoveReactionResponse()
+	msg, code, errMsg := s.changeReaction(ctx, req.Chat, req.ID, req.Emoji, req.User, false)
+	resp.Code, resp.Msg = code, errMsg
+	if code == 0 {
+		resp.Message = msg
+	}
+	return resp, nil
+}
+
+// changeReaction adds or removes the reaction of user with emoji to the
+// message with ID id. It returns a non-zero code and a message if the
+// reaction is rejected. Reactions change the update time of the message, so
+// clients pulling changes receive them.
+func (s *IMServiceImpl) changeReaction(ctx context.Context, chat string, id int64, emoji, user string, add bool) (*rpc.Message, int32, string) {
+	if emoji == "" {
+		return nil, codeInvalidArgument, "emoji is required"
+	} else if len(emoji) > maxEmojiLen {
+		return nil, codeInvalidArgument, fmt.Sprintf("emoji is longer than %d bytes", maxEmojiLen)
+	}
+	chat, members, err := s.resolveChat(ctx, chat)
+	if err != nil {
+		return nil, errorCode(err), err.Error()
+	}
+	if !containsString(members, user) {
+		return nil, codePermissionDenied, fmt.Sprintf("user %q is not a member of chat %q", user, chat)
+	}
+	// Deleted messages are rejected by the store along with the reaction, so
+	// that reactions cannot be added to messages deleted concurrently.
+	updateTime := time.Now().UnixMicro()
+	msg, err := s.store.React(ctx, chat, id, emoji, user, add, updateTime)
+	if err != nil {
+		return nil, errorCode(err), err.Error()
+	}
+	// Messages left as is keep an earlier update time.
+	if msg.UpdateTime >= updateTime {
+		s.notifier.notify(chat)
+		// The reaction is stored already, see sendBatch.
+		if err := s.inbox.Update(ctx, msg, members); err != nil {
+			klog.CtxErrorf(ctx, "failed to update message %d of chat %q in inboxes: %v", id, chat, err)
+		}
+	}
+	return msg, 0, "success"
+}
@@ -0,0 +1,66 @@
+package main
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/TikTokTechImmersion/assignment_demo_2023/rpc-server/kitex_gen/rpc"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIMServiceImpl_Reactions(t *testing.T) {
+	ctx := context.Background()
+	s := newTestService(t)
+	user := "john"
+	var sendTimes []int64
+	for _, text := range []string{"1", "2"} {
+		resp, err := s.Send(ctx, &rpc.SendRequest{Message: &rpc.Message{Chat: "doe:john", Text: text, Sender: "doe",
+			Reactions: []*rpc.Reaction{{Emoji: "forged", Count: 100}}}})
+		assert.NoError(t, err)
+		sendTimes = append(sendTimes, resp.GetSendTime())
+	}
+
+	tests := []struct {
+		name string
+		req  *rpc.AddReactionRequest
+		code int32
+	}{
+		{name: "no emoji", req: &rpc.AddReactionRequest{Chat: "doe:john", ID: 1, User: "john"}, code: 400},
+		{name: "long emoji", req: &rpc.AddReactionRequest{Chat: "doe:john", ID: 1, User: "john", Emoji: strings.Repeat("x", maxEmojiLen+1)}, code: 400},
+		{name: "not a member", req: &rpc.AddReactionRequest{Chat: "doe:john", ID: 1, User: "jane", Emoji: "+1"}, code: 403},
+		{name: "unknown message", req: &rpc.AddReactionRequest{Chat: "doe:john", ID: 3, User: "john", Emoji: "+1"}, code: 404},
+		{name: "john", req: &rpc.AddReactionRequest{Chat: "doe:john", ID: 1, User: "john", Emoji: "+1"}, code: 0},
+		{name: "doe", req: &rpc.AddReactionRequest{Chat: "john:doe", ID: 1, User: "doe", Emoji: "+1"}, code: 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resp, err := s.AddReaction(ctx, tt.req)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.code, resp.Code, resp.Msg)
+		})
+	}
+
+	resp, err := s.Pull(ctx, &rpc.PullRequest{Chat: "doe:john", User: &user})
+	assert.NoError(t, err)
+	assert.Equal(t, []*rpc.Reaction{{Emoji: "+1", Count: 2, Users: []string{"john", "doe"}}}, resp.Messages[0].Reactions)
+	assert.Nil(t, resp.Messages[1].Reactions)
+
+	removed, err := s.RemoveReaction(ctx, &rpc.RemoveReactionRequest{Chat: "doe:john", ID: 1, User: "john", Emoji: "+1"})
+	assert.NoError(t, err)
+	assert.Equal(t, int32(0), removed.Code)
+	assert.Equal(t, []*rpc.Reaction{{Emoji: "+1", Count: 1, Users: []string{"doe"}}}, removed.Message.Reactions)
+
+	// Reaction changes come through the changes cursor.
+	changes := true
+	resp, err = s.Pull(ctx, &rpc.PullRequest{Chat: "doe:john", User: &user, Cursor: sendTimes[1] + 1, Changes: &changes})
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"1"}, texts(resp.Messages))
+	assert.Equal(t, removed.Message.Reactions, resp.Messages[0].Reactions)
+
+	_, err = s.DeleteMessage(ctx, &rpc.DeleteMessageRequest{Chat: "doe:john", ID: 1, User: "doe"})
+	assert.NoError(t, err)
+	added, err := s.AddReaction(ctx, &rpc.AddReactionRequest{Chat: "doe:john", ID: 1, User: "john", Emoji: "+1"})
+	assert.NoError(t, err)
+	assert.Equal(t, int32(410), added.Code)
+}
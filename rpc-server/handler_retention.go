@@ -0,0 +1,78 @@
+package main
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/TikTokTechImmersion/assignment_demo_2023/rpc-server/kitex_gen/rpc"
+)
+
+// maxRetentionAge bounds Retention.MaxAge and Retention.DisappearAfter, in
+// seconds, so that they cannot overflow as microseconds.
+const maxRetentionAge = 100 * 365 * 24 * 60 * 60
+
+func (s *IMServiceImpl) GetRetention(ctx context.Context, req *rpc.GetRetentionRequest) (*rpc.GetRetentionResponse, error) {
+	resp := rpc.NewGetRetentionResponse()
+	chat, members, err := s.resolveChat(ctx, req.Chat)
+	if err != nil {
+		resp.Code, resp.Msg = errorCode(err), err.Error()
+		return resp, nil
+	}
+	if !containsString(members, req.User) {
+		resp.Code, resp.Msg = codePermissionDenied, fmt.Sprintf("user %q is not a member of chat %q", req.User, req.Chat)
+		return resp, nil
+	}
+	policy, err := s.retention.Retention(ctx, chat)
+	if err != nil {
+		resp.Code, resp.Msg = codeUnavailable, err.Error()
+		return resp, nil
+	}
+	resp.Code, resp.Msg = 0, "success"
+	resp.Retention = policy
+	return resp, nil
+}
+
+// SetRetention lets any member of a chat change its retention policy. The
+// messages it expires are hidden at once and purged by the compactor later.
+// DisappearAfter only applies to the messages sent from then on.
+func (s *IMServiceImpl) SetRetention(ctx context.Context, req *rpc.SetRetentionRequest) (*rpc.SetRetentionResponse, error) {
+	resp := rpc.NewSetRetentionResponse()
+	policy := req.GetRetention()
+	if policy == nil {
+		resp.Code, resp.Msg = codeInvalidArgument, "retention is required"
+		return resp, nil
+	}
+	if err := checkRetention(policy); err != nil {
+		resp.Code, resp.Msg = codeInvalidArgument, err.Error()
+		return resp, nil
+	}
+	chat, members, err := s.resolveChat(ctx, req.Chat)
+	if err != nil {
+		resp.Code, resp.Msg = errorCode(err), err.Error()
+		return resp, nil
+	}
+	if !containsString(members, req.User) {
+		resp.Code, resp.Msg = codePermissionDenied, fmt.Sprintf("user %q is not a member of chat %q", req.User, req.Chat)
+		return resp, nil
+	}
+	if err := s.retention.SetRetention(ctx, chat, policy); err != nil {
+		resp.Code, resp.Msg = codeUnavailable, err.Error()
+		return resp, nil
+	}
+	resp.Code, resp.Msg = 0, "success"
+	return resp, nil
+}
+
+// checkRetention checks that every limit of policy is either 0, for no
+// limit, or a valid limit.
+func checkRetention(policy *rpc.Retention) error {
+	switch {
+	case policy.MaxAge < 0 || policy.MaxAge > maxRetentionAge:
+		return fmt.Errorf("max age must be between 0 and %d seconds", maxRetentionAge)
+	case policy.MaxCount < 0:
+		return fmt.Errorf("max count must not be negative")
+	case policy.DisappearAfter < 0 || policy.DisappearAfter > maxRetentionAge:
+		return fmt.Errorf("disappear after must be between 0 and %d seconds", maxRetentionAge)
+	}
+	return nil
+}
@@ -0,0 +1,108 @@
+package main
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/TikTokTechImmersion/assignment_demo_2023/rpc-server/kitex_gen/rpc"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIMServiceImpl_SetRetention(t *testing.T) {
+	ctx := context.Background()
+	s := newTestService(t)
+
+	tests := []struct {
+		name string
+		req  *rpc.SetRetentionRequest
+		code int32
+	}{
+		{name: "no retention", req: &rpc.SetRetentionRequest{Chat: "doe:john", User: "john"}, code: 400},
+		{name: "negative max age", req: &rpc.SetRetentionRequest{Chat: "doe:john", User: "john", Retention: &rpc.Retention{MaxAge: -1}}, code: 400},
+		{name: "negative max count", req: &rpc.SetRetentionRequest{Chat: "doe:john", User: "john", Retention: &rpc.Retention{MaxCount: -1}}, code: 400},
+		{name: "long disappear after", req: &rpc.SetRetentionRequest{Chat: "doe:john", User: "john", Retention: &rpc.Retention{DisappearAfter: maxRetentionAge + 1}}, code: 400},
+		{name: "unknown chat", req: &rpc.SetRetentionRequest{Chat: "group-1", User: "john", Retention: &rpc.Retention{}}, code: 404},
+		{name: "not a member", req: &rpc.SetRetentionRequest{Chat: "doe:john", User: "jane", Retention: &rpc.Retention{}}, code: 403},
+		{name: "success", req: &rpc.SetRetentionRequest{Chat: "john:doe", User: "john", Retention: &rpc.Retention{MaxCount: 2, DisappearAfter: 60}}, code: 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resp, err := s.SetRetention(ctx, tt.req)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.code, resp.Code, resp.Msg)
+		})
+	}
+
+	got, err := s.GetRetention(ctx, &rpc.GetRetentionRequest{Chat: "doe:john", User: "doe"})
+	assert.NoError(t, err)
+	assert.Equal(t, int32(0), got.Code)
+	assert.Equal(t, &rpc.Retention{MaxCount: 2, DisappearAfter: 60}, got.Retention)
+	got, err = s.GetRetention(ctx, &rpc.GetRetentionRequest{Chat: "doe:john", User: "jane"})
+	assert.NoError(t, err)
+	assert.Equal(t, int32(403), got.Code)
+}
+
+func TestIMServiceImpl_Retention(t *testing.T) {
+	ctx := context.Background()
+	s := newTestService(t)
+	user := "john"
+	for _, text := range []string{"1", "2", "3"} {
+		resp, err := s.Send(ctx, &rpc.SendRequest{Message: &rpc.Message{Chat: "doe:john", Text: text, Sender: "doe", ExpireTime: 1}})
+		assert.NoError(t, err)
+		assert.Equal(t, int32(0), resp.Code)
+	}
+	// Clients cannot make messages disappear without a policy.
+	resp, err := s.Pull(ctx, &rpc.PullRequest{Chat: "doe:john", User: &user})
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"1", "2", "3"}, texts(resp.Messages))
+	assert.Equal(t, int64(0), resp.Messages[0].ExpireTime)
+
+	set, err := s.SetRetention(ctx, &rpc.SetRetentionRequest{Chat: "doe:john", User: "john", Retention: &rpc.Retention{MaxCount: 3, DisappearAfter: 60}})
+	assert.NoError(t, err)
+	assert.Equal(t, int32(0), set.Code)
+	sent, err := s.Send(ctx, &rpc.SendRequest{Message: &rpc.Message{Chat: "doe:john", Text: "4", Sender: "doe"}})
+	assert.NoError(t, err)
+	// An expired disappearing message, which the compactor did not purge yet.
+	expired := &rpc.Message{Chat: "doe:john", Text: "5", Sender: "doe", SendTime: time.Now().UnixMicro(), ExpireTime: 1}
+	assert.NoError(t, s.store.Append(ctx, expired))
+	assert.NoError(t, s.inbox.Post(ctx, []InboxPost{{Message: expired, Members: []string{"doe", "john"}}}))
+
+	resp, err = s.Pull(ctx, &rpc.PullRequest{Chat: "doe:john", User: &user, Limit: 10})
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"3", "4"}, texts(resp.Messages))
+	assert.Equal(t, sent.GetSendTime()+60e6, resp.Messages[1].ExpireTime)
+
+	// Pages stay full past expired messages, in both directions.
+	reverse := true
+	tests := []struct {
+		name string
+		req  *rpc.PullRequest
+		want []string
+		more bool
+	}{
+		{name: "forward", req: &rpc.PullRequest{Chat: "doe:john", User: &user, Limit: 1}, want: []string{"3"}, more: true},
+		{name: "reverse", req: &rpc.PullRequest{Chat: "doe:john", User: &user, Limit: 1, Reverse: &reverse}, want: []string{"4"}, more: true},
+		{name: "reverse last page", req: &rpc.PullRequest{Chat: "doe:john", User: &user, Limit: 2, Reverse: &reverse}, want: []string{"4", "3"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resp, err := s.Pull(ctx, tt.req)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, texts(resp.Messages))
+			assert.Equal(t, tt.more, resp.GetHasMore())
+		})
+	}
+
+	_, err = s.store.Get(ctx, "doe:john", expired.ID)
+	assert.ErrorIs(t, err, errMessageNotFound)
+	_, err = s.store.Get(ctx, "doe:john", 1)
+	assert.ErrorIs(t, err, errMessageNotFound)
+
+	// The inbox does not show the expired last message either.
+	chats, err := s.ListChats(ctx, &rpc.ListChatsRequest{User: "john"})
+	assert.NoError(t, err)
+	if assert.Len(t, chats.Chats, 1) {
+		assert.Nil(t, chats.Chats[0].LastMessage)
+	}
+}
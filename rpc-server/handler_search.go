@@ -0,0 +1,79 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/TikTokTechImmersion/assignment_demo_2023/rpc-server/kitex_gen/rpc"
+)
+
+// maxSearchLimit bounds SearchRequest.Limit, every result being read from the
+// MessageStore on its own.
+const maxSearchLimit = 100
+
+func (s *IMServiceImpl) Search(ctx context.Context, req *rpc.SearchRequest) (*rpc.SearchResponse, error) {
+	resp := rpc.NewSearchResponse()
+	if len(tokenize(req.Query)) == 0 {
+		resp.Code, resp.Msg = codeInvalidArgument, "query has no words to search for"
+		return resp, nil
+	}
+	var chats []string
+	if req.IsSetChat() {
+		chat, members, err := s.resolveChat(ctx, req.GetChat())
+		if err != nil {
+			resp.Code, resp.Msg = errorCode(err), err.Error()
+			return resp, nil
+		}
+		if !containsString(members, req.User) {
+			resp.Code, resp.Msg = codePermissionDenied, fmt.Sprintf("user %q is not a member of chat %q", req.User, req.GetChat())
+			return resp, nil
+		}
+		chats = []string{chat}
+	} else {
+		// The inbox of the user lists the chats they are a member of.
+		entries, err := s.inbox.List(ctx, req.User, 0, 0)
+		if err != nil {
+			resp.Code, resp.Msg = codeUnavailable, err.Error()
+			return resp, nil
+		}
+		for _, e := range entries {
+			chats = append(chats, e.Chat)
+		}
+	}
+	limit := int(req.Limit)
+	if limit <= 0 {
+		limit = defaultPullLimit
+	} else if limit > maxSearchLimit {
+		limit = maxSearchLimit
+	}
+
+	hits, err := s.search.Search(ctx, chats, req.Query, req.Cursor, limit+1)
+	if err != nil {
+		resp.Code, resp.Msg = codeUnavailable, err.Error()
+		return resp, nil
+	}
+	hasMore := len(hits) > limit
+	if hasMore {
+		resp.NextCursor = &hits[limit].Position
+		hits = hits[:limit]
+	}
+	msgs := make([]*rpc.Message, 0, len(hits))
+	for _, hit := range hits {
+		msg, err := s.store.Get(ctx, hit.Chat, hit.ID)
+		if errors.Is(err, errMessageNotFound) {
+			// The index may be ahead of the store after a failed update.
+			continue
+		} else if err != nil {
+			resp.Code, resp.Msg = codeUnavailable, err.Error()
+			return resp, nil
+		}
+		if !msg.Deleted {
+			msgs = append(msgs, msg)
+		}
+	}
+	resp.Code, resp.Msg = 0, "success"
+	resp.Messages = msgs
+	resp.HasMore = &hasMore
+	return resp, nil
+}
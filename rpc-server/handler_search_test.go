@@ -0,0 +1,63 @@
+package main
+
+import (
+	"context"
+	"testing"
+
+	"github.com/TikTokTechImmersion/assignment_demo_2023/rpc-server/kitex_gen/rpc"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIMServiceImpl_Search(t *testing.T) {
+	ctx := context.Background()
+	s := newTestService(t)
+	send := func(chat, sender, text string) {
+		resp, err := s.Send(ctx, &rpc.SendRequest{Message: &rpc.Message{Chat: chat, Text: text, Sender: sender}})
+		assert.NoError(t, err)
+		assert.Equal(t, int32(0), resp.Code)
+	}
+	send("doe:john", "doe", "lunch at noon?")
+	send("jane:john", "jane", "lunch tomorrow")
+	send("doe:jane", "doe", "lunch with john?")
+	send("doe:john", "john", "sure, see you at lunch")
+
+	resp, err := s.Search(ctx, &rpc.SearchRequest{User: "john", Query: "LUNCH", Limit: 2})
+	assert.NoError(t, err)
+	assert.Equal(t, int32(0), resp.Code)
+	assert.Equal(t, []string{"sure, see you at lunch", "lunch tomorrow"}, texts(resp.Messages))
+	assert.True(t, resp.GetHasMore())
+	resp, err = s.Search(ctx, &rpc.SearchRequest{User: "john", Query: "LUNCH", Cursor: resp.GetNextCursor(), Limit: 2})
+	assert.NoError(t, err)
+	// jane and doe's chat is not john's.
+	assert.Equal(t, []string{"lunch at noon?"}, texts(resp.Messages))
+	assert.False(t, resp.GetHasMore())
+
+	// Edits and deletes are indexed.
+	edit, err := s.EditMessage(ctx, &rpc.EditMessageRequest{Chat: "doe:john", ID: 1, User: "doe", Text: "dinner at eight?"})
+	assert.NoError(t, err)
+	assert.Equal(t, int32(0), edit.Code)
+	_, err = s.DeleteMessage(ctx, &rpc.DeleteMessageRequest{Chat: "doe:john", ID: 2, User: "john"})
+	assert.NoError(t, err)
+	chat := "john:doe"
+	resp, err = s.Search(ctx, &rpc.SearchRequest{User: "john", Chat: &chat, Query: "lunch"})
+	assert.NoError(t, err)
+	assert.Empty(t, resp.Messages)
+	resp, err = s.Search(ctx, &rpc.SearchRequest{User: "john", Chat: &chat, Query: "dinner"})
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"dinner at eight?"}, texts(resp.Messages))
+
+	// The postings of the deleted message are not kept.
+	x := s.search.(*fileSearchIndex)
+	assert.NotContains(t, x.positions, searchKey{chat: "doe:john", id: 2})
+	for _, token := range []string{"sure", "see", "you"} {
+		assert.NotContains(t, x.postings["doe:john"], token)
+	}
+
+	jane := "doe:jane"
+	resp, err = s.Search(ctx, &rpc.SearchRequest{User: "john", Chat: &jane, Query: "lunch"})
+	assert.NoError(t, err)
+	assert.Equal(t, int32(403), resp.Code)
+	resp, err = s.Search(ctx, &rpc.SearchRequest{User: "john", Query: " ? "})
+	assert.NoError(t, err)
+	assert.Equal(t, int32(400), resp.Code)
+}
@@ -0,0 +1,111 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/TikTokTechImmersion/assignment_demo_2023/rpc-server/kitex_gen/rpc"
+)
+
+func (s *IMServiceImpl) Subscribe(ctx context.Context, req *rpc.SubscribeRequest) (*rpc.SubscribeResponse, error) {
+	resp := rpc.NewSubscribeResponse()
+	if len(req.Chats) == 0 {
+		resp.Code, resp.Msg = codeInvalidArgument, "at least one chat is required"
+		return resp, nil
+	}
+	chats := make([]string, 0, len(req.Chats))
+	cursors := make([]*rpc.ChatCursor, 0, len(req.Chats))
+	for _, c := range req.Chats {
+		cursor, code, msg := s.resolveCursor(ctx, req.User, c)
+		if code != 0 {
+			resp.Code, resp.Msg = code, msg
+			return resp, nil
+		}
+		chats = append(chats, cursor.Chat)
+		cursors = append(cursors, cursor)
+	}
+	limit := int(req.GetLimit())
+	if limit <= 0 {
+		limit = defaultPullLimit
+	}
+	wait := time.Duration(req.GetWaitMs()) * time.Millisecond
+	if wait > maxPullWait {
+		wait = maxPullWait
+	}
+
+	var msgs []*rpc.Message
+	err := s.waitFor(ctx, chats, wait, func() (bool, error) {
+		queries := make([]RangeQuery, 0, len(cursors))
+		for _, c := range cursors {
+			queries = append(queries, RangeQuery{Chat: c.Chat, Cursor: c.Cursor, Limit: limit})
+		}
+		pages, err := s.pullPages(ctx, queries)
+		if err != nil {
+			return false, err
+		}
+		msgs = msgs[:0]
+		for i, p := range pages {
+			msgs = append(msgs, p.msgs...)
+			if p.hasMore {
+				cursors[i].Cursor = p.nextCursor
+			} else if len(p.msgs) > 0 {
+				cursors[i].Cursor = p.msgs[len(p.msgs)-1].SendTime + 1
+			}
+		}
+		return len(msgs) > 0, nil
+	})
+	if err != nil {
+		resp.Code, resp.Msg = codeUnavailable, err.Error()
+		return resp, nil
+	}
+	resp.Code, resp.Msg = 0, "success"
+	resp.Messages = msgs
+	resp.Cursors = cursors
+	return resp, nil
+}
+
+// resolveCursor resolves the chat of c, of which user must be a member, and
+// where to start reading it. It returns a non-zero code and a message if c
+// is rejected.
+func (s *IMServiceImpl) resolveCursor(ctx context.Context, user string, c *rpc.ChatCursor) (*rpc.ChatCursor, int32, string) {
+	chat, members, err := s.resolveChat(ctx, c.GetChat())
+	if err != nil {
+		return nil, errorCode(err), err.Error()
+	}
+	if !containsString(members, user) {
+		return nil, codePermissionDenied, fmt.Sprintf("user %q is not a member of chat %q", user, c.GetChat())
+	}
+	cursor := c.GetCursor()
+	if c.GetAfterID() > 0 {
+		if cursor, err = s.cursorAfter(ctx, chat, c.GetAfterID()); err != nil {
+			return nil, errorCode(err), err.Error()
+		}
+	}
+	return &rpc.ChatCursor{Chat: chat, Cursor: cursor}, 0, ""
+}
+
+// cursorAfter returns the cursor of the first message of chat after the one
+// with ID id. If that message was purged since, or expired, the first message
+// left with a greater ID is looked up instead, so that streams resuming after
+// it do not fail for good.
+func (s *IMServiceImpl) cursorAfter(ctx context.Context, chat string, id int64) (int64, error) {
+	msg, err := s.store.Get(ctx, chat, id)
+	if err == nil {
+		return msg.SendTime + 1, nil
+	} else if !errors.Is(err, errMessageNotFound) {
+		return 0, err
+	}
+	if msg, err = s.store.Next(ctx, chat, id); err == nil {
+		return msg.SendTime, nil
+	} else if !errors.Is(err, errMessageNotFound) {
+		return 0, err
+	}
+	// Every message left is older, resume at the next one sent.
+	last, err := s.store.Range(ctx, chat, 0, 1, true)
+	if err != nil || len(last) == 0 {
+		return 0, err
+	}
+	return last[0].SendTime + 1, nil
+}
@@ -0,0 +1,94 @@
+package main
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/TikTokTechImmersion/assignment_demo_2023/rpc-server/kitex_gen/rpc"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIMServiceImpl_Subscribe(t *testing.T) {
+	ctx := context.Background()
+	s := newTestService(t)
+	send := func(chat, text string) {
+		resp, err := s.Send(ctx, &rpc.SendRequest{Message: &rpc.Message{Chat: chat, Text: text, Sender: "john"}})
+		assert.NoError(t, err)
+		assert.Equal(t, int32(0), resp.Code)
+	}
+	send("a:john", "a1")
+	send("b:john", "b1")
+	send("a:john", "a2")
+
+	waitMs := int32(5000)
+	req := &rpc.SubscribeRequest{
+		User:   "john",
+		Chats:  []*rpc.ChatCursor{{Chat: "john:a"}, {Chat: "b:john"}},
+		WaitMs: &waitMs,
+	}
+	resp, err := s.Subscribe(ctx, req)
+	assert.NoError(t, err)
+	assert.Equal(t, int32(0), resp.Code)
+	assert.Equal(t, []string{"a1", "a2", "b1"}, texts(resp.Messages))
+	assert.Equal(t, "a:john", resp.Cursors[0].Chat)
+
+	// Resuming from the returned cursors waits for the next message.
+	go func() {
+		time.Sleep(20 * time.Millisecond)
+		send("b:john", "b2")
+	}()
+	start := time.Now()
+	resp, err = s.Subscribe(ctx, &rpc.SubscribeRequest{User: "john", Chats: resp.Cursors, WaitMs: &waitMs})
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"b2"}, texts(resp.Messages))
+	assert.Less(t, time.Since(start), time.Second)
+
+	// Resuming after a message ID ignores the cursor.
+	afterID, missingID := int64(1), int64(42)
+	resp, err = s.Subscribe(ctx, &rpc.SubscribeRequest{
+		User:  "john",
+		Chats: []*rpc.ChatCursor{{Chat: "a:john", AfterID: &afterID}},
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"a2"}, texts(resp.Messages))
+	resp, err = s.Subscribe(ctx, &rpc.SubscribeRequest{
+		User:  "john",
+		Chats: []*rpc.ChatCursor{{Chat: "a:john", AfterID: &missingID}},
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, int32(0), resp.Code)
+	assert.Empty(t, resp.Messages)
+
+	// Resuming after a purged message starts at the next message left.
+	send("a:john", "a3")
+	_, err = s.store.(*retainedStore).MessageStore.Purge(ctx, PurgeQuery{Chat: "a:john", MaxID: 2})
+	assert.NoError(t, err)
+	for _, id := range []int64{1, 2} {
+		resp, err = s.Subscribe(ctx, &rpc.SubscribeRequest{
+			User:  "john",
+			Chats: []*rpc.ChatCursor{{Chat: "a:john", AfterID: &id}},
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, int32(0), resp.Code)
+		assert.Equal(t, []string{"a3"}, texts(resp.Messages))
+	}
+	// Or after an expired message, older messages being left.
+	assert.NoError(t, s.store.Append(ctx, &rpc.Message{Chat: "a:john", Text: "a4", Sender: "john", ExpireTime: 1}))
+	send("a:john", "a5")
+	expiredID := int64(4)
+	resp, err = s.Subscribe(ctx, &rpc.SubscribeRequest{
+		User:  "john",
+		Chats: []*rpc.ChatCursor{{Chat: "a:john", AfterID: &expiredID}},
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, int32(0), resp.Code)
+	assert.Equal(t, []string{"a5"}, texts(resp.Messages))
+
+	resp, err = s.Subscribe(ctx, &rpc.SubscribeRequest{User: "jane", Chats: req.Chats})
+	assert.NoError(t, err)
+	assert.Equal(t, int32(403), resp.Code)
+	resp, err = s.Subscribe(ctx, &rpc.SubscribeRequest{User: "john"})
+	assert.NoError(t, err)
+	assert.Equal(t, int32(400), resp.Code)
+}
@@ -3,37 +3,291 @@ package main
 import (
 	"context"
 	"errors"
+	"fmt"
+	"path/filepath"
 	"testing"
+	"time"
 
 	"github.com/TikTokTechImmersion/assignment_demo_2023/rpc-server/kitex_gen/rpc"
 	"github.com/stretchr/testify/assert"
 )
 
+func newTestService(t *testing.T) *IMServiceImpl {
+	dir := t.TempDir()
+	store, err := OpenFileStore(filepath.Join(dir, "messages.log"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { store.Close() })
+	members, err := OpenFileMemberStore(filepath.Join(dir, "members.log"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { members.Close() })
+	inbox, err := OpenFileInboxStore(filepath.Join(dir, "inbox.log"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { inbox.Close() })
+	search, err := OpenFileSearchIndex(filepath.Join(dir, "search.log"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { search.Close() })
+	retention, err := OpenFileRetentionStore(filepath.Join(dir, "retention.log"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { retention.Close() })
+	blobs, err := OpenFileBlobRefStore(filepath.Join(dir, "blobs.log"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { blobs.Close() })
+	return NewIMServiceImpl(store, members, inbox, search, retention, blobs, nil)
+}
+
 func TestIMServiceImpl_Send(t *testing.T) {
 	type args struct {
 		ctx context.Context
 		req *rpc.SendRequest
 	}
 	tests := []struct {
-		name    string
-		args    args
-		wantErr error
+		name     string
+		args     args
+		wantErr  error
+		wantCode int32
 	}{
 		{
 			name: "success",
+			args: args{
+				ctx: context.Background(),
+				req: &rpc.SendRequest{Message: &rpc.Message{Chat: "doe:john", Text: "hi", Sender: "john"}},
+			},
+			wantErr:  nil,
+			wantCode: 0,
+		},
+		{
+			name: "malformed chat",
+			args: args{
+				ctx: context.Background(),
+				req: &rpc.SendRequest{Message: &rpc.Message{Chat: "john:", Text: "hi", Sender: "john"}},
+			},
+			wantErr:  nil,
+			wantCode: 400,
+		},
+		{
+			name: "sender not a member",
+			args: args{
+				ctx: context.Background(),
+				req: &rpc.SendRequest{Message: &rpc.Message{Chat: "doe:john", Text: "hi", Sender: "jane"}},
+			},
+			wantErr:  nil,
+			wantCode: 403,
+		},
+		{
+			name: "missing message",
 			args: args{
 				ctx: context.Background(),
 				req: &rpc.SendRequest{},
 			},
-			wantErr: nil,
+			wantErr:  nil,
+			wantCode: 400,
 		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			s := &IMServiceImpl{}
+			s := newTestService(t)
 			got, err := s.Send(tt.args.ctx, tt.args.req)
 			assert.True(t, errors.Is(err, tt.wantErr))
 			assert.NotNil(t, got)
+			assert.Equal(t, tt.wantCode, got.Code)
+		})
+	}
+}
+
+func TestIMServiceImpl_SendIdempotent(t *testing.T) {
+	ctx := context.Background()
+	s := newTestService(t)
+	send := func(chat, sender, key string) *rpc.SendResponse {
+		resp, err := s.Send(ctx, &rpc.SendRequest{
+			Message:        &rpc.Message{Chat: chat, Text: "hi", Sender: sender},
+			IdempotencyKey: &key,
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, int32(0), resp.Code)
+		return resp
+	}
+	first := send("doe:john", "john", "k1")
+	retry := send("john:doe", "john", "k1")
+	assert.Equal(t, first.GetID(), retry.GetID())
+	assert.Equal(t, first.GetSendTime(), retry.GetSendTime())
+	// Keys of other senders and chats are distinct.
+	assert.NotEqual(t, first.GetID(), send("doe:john", "doe", "k1").GetID())
+	assert.Equal(t, int64(1), send("jane:john", "john", "k1").GetID())
+	assert.NotEqual(t, first.GetID(), send("doe:john", "john", "k2").GetID())
+
+	user := "john"
+	resp, err := s.Pull(ctx, &rpc.PullRequest{Chat: "doe:john", User: &user})
+	assert.NoError(t, err)
+	assert.Len(t, resp.Messages, 3)
+}
+
+func TestIMServiceImpl_Pull(t *testing.T) {
+	ctx := context.Background()
+	s := newTestService(t)
+	var sendTimes []int64
+	for i, text := range []string{"a", "b", "c"} {
+		resp, err := s.Send(ctx, &rpc.SendRequest{Message: &rpc.Message{
+			Chat: "john:doe", Text: text, Sender: "john", SendTime: 42,
+		}})
+		assert.NoError(t, err)
+		assert.Equal(t, int32(0), resp.Code)
+		assert.Equal(t, int64(i+1), resp.GetID())
+		assert.NotEqual(t, int64(42), resp.GetSendTime(), "send time must be assigned by the server")
+		sendTimes = append(sendTimes, resp.GetSendTime())
+	}
+	_, err := s.Send(ctx, &rpc.SendRequest{Message: &rpc.Message{Chat: "a:b", Text: "other", Sender: "a"}})
+	assert.NoError(t, err)
+
+	user := "doe"
+	resp, err := s.Pull(ctx, &rpc.PullRequest{Chat: "doe:john", Cursor: sendTimes[1], User: &user})
+	assert.NoError(t, err)
+	assert.Equal(t, int32(0), resp.Code)
+	assert.Equal(t, []string{"b", "c"}, texts(resp.Messages))
+	assert.Equal(t, int64(2), resp.Messages[0].ID)
+
+	reverse := true
+	resp, err = s.Pull(ctx, &rpc.PullRequest{Chat: "doe:john", Limit: 2, Reverse: &reverse, User: &user})
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"c", "b"}, texts(resp.Messages))
+
+	resp, err = s.Pull(ctx, &rpc.PullRequest{Chat: "john:doe", User: &user})
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"a", "b", "c"}, texts(resp.Messages), "member order must not matter")
+	assert.Equal(t, "doe:john", resp.Messages[0].Chat)
+
+	resp, err = s.Pull(ctx, &rpc.PullRequest{Chat: "doe:", User: &user})
+	assert.NoError(t, err)
+	assert.Equal(t, int32(400), resp.Code)
+
+	jane := "jane"
+	resp, err = s.Pull(ctx, &rpc.PullRequest{Chat: "doe:john", User: &jane})
+	assert.NoError(t, err)
+	assert.Equal(t, int32(403), resp.Code)
+}
+
+func texts(msgs []*rpc.Message) []string {
+	out := make([]string, 0, len(msgs))
+	for _, msg := range msgs {
+		out = append(out, msg.Text)
+	}
+	return out
+}
+
+func TestIMServiceImpl_PullPagination(t *testing.T) {
+	ctx := context.Background()
+	s := newTestService(t)
+	// Messages sent in a burst may share a microsecond, their send times must
+	// still be distinct so that cursors stay unambiguous.
+	const all = "abcdefgh"
+	for _, text := range all {
+		_, err := s.Send(ctx, &rpc.SendRequest{Message: &rpc.Message{
+			Chat: "doe:john", Text: string(text), Sender: "john",
+		}})
+		assert.NoError(t, err)
+	}
+
+	tests := []struct {
+		name    string
+		limit   int32
+		reverse bool
+		want    string
+	}{
+		{name: "default limit", limit: 0, want: "abcdefgh"},
+		{name: "limit 1", limit: 1, want: "abcdefgh"},
+		{name: "limit 3", limit: 3, want: "abcdefgh"},
+		{name: "limit 8", limit: 8, want: "abcdefgh"},
+		{name: "reverse limit 2", limit: 2, reverse: true, want: "hgfedcba"},
+		{name: "reverse limit 3", limit: 3, reverse: true, want: "hgfedcba"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got string
+			user := "john"
+			req := &rpc.PullRequest{Chat: "doe:john", Limit: tt.limit, Reverse: &tt.reverse, User: &user}
+			for pages := 0; pages < len(all); pages++ {
+				resp, err := s.Pull(ctx, req)
+				assert.NoError(t, err)
+				assert.Equal(t, int32(0), resp.Code)
+				for _, msg := range resp.Messages {
+					got += msg.Text
+				}
+				if !resp.GetHasMore() {
+					break
+				}
+				assert.NotEmpty(t, resp.Messages)
+				req.Cursor = resp.GetNextCursor()
+			}
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestIMServiceImpl_PullWait(t *testing.T) {
+	ctx := context.Background()
+	s := newTestService(t)
+	user := "john"
+
+	tests := []struct {
+		name     string
+		chat     string
+		waitMs   int32
+		send     bool
+		want     []string
+		wantWait time.Duration
+	}{
+		{name: "no wait", chat: "a:john", waitMs: 0, send: false, want: []string{}},
+		{name: "timeout", chat: "b:john", waitMs: 50, send: false, want: []string{}, wantWait: 50 * time.Millisecond},
+		{name: "woken by send", chat: "c:john", waitMs: 5000, send: true, want: []string{"hi"}, wantWait: 20 * time.Millisecond},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.send {
+				go func(chat string) {
+					time.Sleep(20 * time.Millisecond)
+					_, err := s.Send(ctx, &rpc.SendRequest{Message: &rpc.Message{Chat: chat, Text: "hi", Sender: user}})
+					assert.NoError(t, err)
+				}(tt.chat)
+			}
+			start := time.Now()
+			resp, err := s.Pull(ctx, &rpc.PullRequest{Chat: tt.chat, User: &user, WaitMs: &tt.waitMs})
+			assert.NoError(t, err)
+			assert.Equal(t, int32(0), resp.Code)
+			assert.Equal(t, tt.want, texts(resp.Messages))
+			assert.GreaterOrEqual(t, time.Since(start), tt.wantWait)
+			assert.Less(t, time.Since(start), time.Second)
+		})
+	}
+}
+
+func TestErrorCode(t *testing.T) {
+	tests := []struct {
+		name string
+		err  error
+		want rpc.ErrorCode
+	}{
+		{name: "invalid chat", err: errInvalidChat, want: rpc.ErrorCode_INVALID_ARGUMENT},
+		{name: "chat not found", err: errChatNotFound, want: rpc.ErrorCode_NOT_FOUND},
+		{name: "message not found", err: fmt.Errorf("message 1: %w", errMessageNotFound), want: rpc.ErrorCode_NOT_FOUND},
+		{name: "chat exists", err: errChatExists, want: rpc.ErrorCode_ALREADY_EXISTS},
+		{name: "not the sender", err: fmt.Errorf("%w: message 1 was not sent by \"a\"", errPermissionDenied), want: rpc.ErrorCode_PERMISSION_DENIED},
+		{name: "message deleted", err: errMessageDeleted, want: rpc.ErrorCode_DELETED},
+		{name: "store failure", err: errors.New("connection refused"), want: rpc.ErrorCode_UNAVAILABLE},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, int32(tt.want), errorCode(tt.err))
 		})
 	}
 }
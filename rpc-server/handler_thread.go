@@ -0,0 +1,63 @@
+package main
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/TikTokTechImmersion/assignment_demo_2023/rpc-server/kitex_gen/rpc"
+)
+
+// threadRoot returns the ID of the first message of the thread that a reply
+// to the message of chat with ID id belongs to. Replies to a reply join the
+// thread of the message it replied to, so threads are never nested.
+func (s *IMServiceImpl) threadRoot(ctx context.Context, chat string, id int64) (int64, error) {
+	parent, err := s.store.Get(ctx, chat, id)
+	if err != nil {
+		return 0, err
+	}
+	if parent.RootID != 0 {
+		return parent.RootID, nil
+	}
+	return parent.ID, nil
+}
+
+func (s *IMServiceImpl) PullThread(ctx context.Context, req *rpc.PullThreadRequest) (*rpc.PullThreadResponse, error) {
+	resp := rpc.NewPullThreadResponse()
+	chat, members, err := s.resolveChat(ctx, req.Chat)
+	if err != nil {
+		resp.Code, resp.Msg = errorCode(err), err.Error()
+		return resp, nil
+	}
+	if !containsString(members, req.User) {
+		resp.Code, resp.Msg = codePermissionDenied, fmt.Sprintf("user %q is not a member of chat %q", req.User, req.Chat)
+		return resp, nil
+	}
+	root, err := s.store.Get(ctx, chat, req.RootID)
+	if err != nil {
+		resp.Code, resp.Msg = errorCode(err), err.Error()
+		return resp, nil
+	}
+	if root.RootID != 0 {
+		resp.Code, resp.Msg = codeInvalidArgument, fmt.Sprintf("message %d is a reply in the thread of message %d", root.ID, root.RootID)
+		return resp, nil
+	}
+	limit := int(req.Limit)
+	if limit <= 0 {
+		limit = defaultPullLimit
+	}
+	msgs, err := s.store.Thread(ctx, chat, root.ID, req.Cursor, limit+1)
+	if err != nil {
+		resp.Code, resp.Msg = codeUnavailable, err.Error()
+		return resp, nil
+	}
+	hasMore := len(msgs) > limit
+	if hasMore {
+		resp.NextCursor = &msgs[limit].SendTime
+		msgs = msgs[:limit]
+	}
+	resp.Code, resp.Msg = 0, "success"
+	resp.Root = root
+	resp.Messages = msgs
+	resp.HasMore = &hasMore
+	return resp, nil
+}
@@ -0,0 +1,57 @@
+package main
+
+import (
+	"context"
+	"testing"
+
+	"github.com/TikTokTechImmersion/assignment_demo_2023/rpc-server/kitex_gen/rpc"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIMServiceImpl_PullThread(t *testing.T) {
+	ctx := context.Background()
+	s := newTestService(t)
+	send := func(text string, replyTo int64) *rpc.SendResponse {
+		resp, err := s.Send(ctx, &rpc.SendRequest{Message: &rpc.Message{Chat: "doe:john", Text: text, Sender: "doe", ReplyTo: replyTo, RootID: 42}})
+		assert.NoError(t, err)
+		return resp
+	}
+	send("1", 0)
+	send("1.1", 1)
+	send("2", 0)
+	send("1.2", 2) // a reply to a reply joins the thread of its root
+	send("1.3", 1)
+	resp := send("x", 9)
+	assert.Equal(t, int32(404), resp.Code)
+
+	thread, err := s.PullThread(ctx, &rpc.PullThreadRequest{Chat: "john:doe", RootID: 1, Limit: 2, User: "john"})
+	assert.NoError(t, err)
+	assert.Equal(t, int32(0), thread.Code)
+	assert.Equal(t, "1", thread.Root.Text)
+	assert.Equal(t, []string{"1.1", "1.2"}, texts(thread.Messages))
+	assert.Equal(t, int64(2), thread.Messages[1].ReplyTo)
+	assert.Equal(t, int64(1), thread.Messages[1].RootID)
+	assert.True(t, thread.GetHasMore())
+	thread, err = s.PullThread(ctx, &rpc.PullThreadRequest{Chat: "john:doe", RootID: 1, Cursor: thread.GetNextCursor(), Limit: 2, User: "john"})
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"1.3"}, texts(thread.Messages))
+	assert.False(t, thread.GetHasMore())
+
+	tests := []struct {
+		name string
+		req  *rpc.PullThreadRequest
+		code int32
+	}{
+		{name: "no replies", req: &rpc.PullThreadRequest{Chat: "doe:john", RootID: 3, User: "john"}, code: 0},
+		{name: "reply", req: &rpc.PullThreadRequest{Chat: "doe:john", RootID: 2, User: "john"}, code: 400},
+		{name: "unknown message", req: &rpc.PullThreadRequest{Chat: "doe:john", RootID: 9, User: "john"}, code: 404},
+		{name: "not a member", req: &rpc.PullThreadRequest{Chat: "doe:john", RootID: 1, User: "jane"}, code: 403},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resp, err := s.PullThread(ctx, tt.req)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.code, resp.Code, resp.Msg)
+		})
+	}
+}
@@ -0,0 +1,58 @@
+package main
+
+import (
+	"context"
+
+	"github.com/TikTokTechImmersion/assignment_demo_2023/rpc-server/kitex_gen/rpc"
+)
+
+// InboxStore keeps the inbox of every user: the chats they take part in, with
+// the ID of the last message and the number of unread messages of each chat.
+type InboxStore interface {
+	// Post records messages just stored by the MessageStore in the inboxes of
+	// the members of their chats. Messages count as unread for every member
+	// but their sender, who has read the chat up to them.
+	Post(ctx context.Context, posts []InboxPost) error
+	// Update records a message just updated by the MessageStore in the inboxes
+	// of members. A deleted message no longer counts as unread.
+	Update(ctx context.Context, msg *rpc.Message, members []string) error
+	// Purge removes the messages of chat with IDs ids, purged by the
+	// MessageStore, from the inboxes of its members. They no longer count as
+	// unread, and if the last message of the chat is one of them, it is
+	// replaced by last, the ID of the latest message left, zero if none.
+	Purge(ctx context.Context, chat string, ids []int64, last int64) error
+	// MarkRead moves the read position of user in chat forward to the message
+	// with ID id. Earlier positions are ignored.
+	MarkRead(ctx context.Context, chat, user string, id int64) error
+	// ReadPositions returns the ID of the last message of chat read by each of
+	// users, zero if none.
+	ReadPositions(ctx context.Context, chat string, users []string) ([]int64, error)
+	// Join adds chat to the inboxes of users, if not already there.
+	Join(ctx context.Context, chat string, users []string) error
+	// Leave removes chat from the inboxes of users.
+	Leave(ctx context.Context, chat string, users []string) error
+	// List returns up to limit chats of the inbox of user, sorted by activity
+	// time in descending order, starting at cursor inclusively. A zero cursor
+	// starts from the latest chat, a non-positive limit returns every chat.
+	List(ctx context.Context, user string, cursor int64, limit int) ([]*InboxEntry, error)
+	// Close releases the resources held by the store.
+	Close() error
+}
+
+// InboxPost is a message to record in the inboxes of members.
+type InboxPost struct {
+	Message *rpc.Message
+	Members []string
+}
+
+// InboxEntry is a chat in the inbox of a user.
+type InboxEntry struct {
+	Chat string
+	// ActiveTime is the time of the last activity of the chat, the send time
+	// of its last message or the time the user joined it. It is unique
+	// within an inbox, moved forward on collisions like message send times.
+	ActiveTime int64
+	LastID     int64 // ID of the last message, zero until one is posted to the chat
+	ReadID     int64 // ID of the last message read by the user
+	Unread     int64 // number of messages the user did not send nor read
+}
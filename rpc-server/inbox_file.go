@@ -0,0 +1,330 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/TikTokTechImmersion/assignment_demo_2023/rpc-server/kitex_gen/rpc"
+)
+
+// inboxOp is a record of the inbox journal. Messages are only recorded by
+// ID, the MessageStore holds their text.
+type inboxOp struct {
+	Op     string // one of "post", "delete", "read", "join", "leave", "purge" or "entry"
+	Chat   string
+	Users  []string
+	Sender string  `json:",omitempty"` // sender of the posted message
+	ID     int64   `json:",omitempty"` // ID of the posted, deleted or last read message
+	Last   int64   `json:",omitempty"` // ID of the last message
+	Time   int64   `json:",omitempty"` // send, join or activity time
+	IDs    []int64 `json:",omitempty"` // purged or unread messages
+}
+
+// fileInboxStore is an InboxStore backed by an append-only journal of inbox
+// changes on local disk, replayed into memory when opened. The journal is
+// rewritten with "entry" records, one per chat of every inbox, once most of
+// its records are stale.
+type fileInboxStore struct {
+	mu      sync.RWMutex
+	journal *journal
+	records int // in the journal
+	users   map[string]*inbox
+}
+
+// inbox is the inbox of a user.
+type inbox struct {
+	chats      map[string]*inboxChat
+	lastActive int64 // latest ActiveTime of the chats
+}
+
+// inboxChat is a chat of an inbox, with the IDs of its unread messages, so
+// that reads can be recorded in any order with respect to posts.
+type inboxChat struct {
+	InboxEntry
+	unread []int64 // sorted
+}
+
+// OpenFileInboxStore opens the inbox journal at path, creating it if needed.
+func OpenFileInboxStore(path string) (InboxStore, error) {
+	s := &fileInboxStore{users: make(map[string]*inbox)}
+	j, err := openJournal(path, func(line []byte) error {
+		var op inboxOp
+		if err := json.Unmarshal(line, &op); err != nil {
+			return err
+		}
+		s.records++
+		return s.apply(op)
+	})
+	if err != nil {
+		return nil, err
+	}
+	s.journal = j
+	return s, nil
+}
+
+func (s *fileInboxStore) apply(op inboxOp) error {
+	switch op.Op {
+	case "post":
+		for _, user := range op.Users {
+			in, c := s.chat(user, op.Chat)
+			in.touch(c, op.Time)
+			// Posts of concurrent sends may be recorded out of order.
+			if op.ID > c.LastID {
+				c.LastID = op.ID
+			}
+			if user == op.Sender {
+				c.read(op.ID)
+			} else if op.ID > c.ReadID {
+				i := sort.Search(len(c.unread), func(i int) bool { return c.unread[i] >= op.ID })
+				c.unread = append(c.unread, 0)
+				copy(c.unread[i+1:], c.unread[i:])
+				c.unread[i] = op.ID
+			}
+		}
+	case "delete":
+		for _, user := range op.Users {
+			in, ok := s.users[user]
+			if !ok || in.chats[op.Chat] == nil {
+				continue
+			}
+			// Deleted messages are no longer worth reading.
+			c := in.chats[op.Chat]
+			i := sort.Search(len(c.unread), func(i int) bool { return c.unread[i] >= op.ID })
+			if i < len(c.unread) && c.unread[i] == op.ID {
+				c.unread = append(c.unread[:i], c.unread[i+1:]...)
+			}
+		}
+	case "purge":
+		purged := make(map[int64]bool, len(op.IDs))
+		for _, id := range op.IDs {
+			purged[id] = true
+		}
+		for _, in := range s.users {
+			c := in.chats[op.Chat]
+			if c == nil {
+				continue
+			}
+			if purged[c.LastID] {
+				c.LastID = op.Last
+			}
+			unread := c.unread[:0]
+			for _, id := range c.unread {
+				if !purged[id] {
+					unread = append(unread, id)
+				}
+			}
+			c.unread = unread
+		}
+	case "entry":
+		for _, user := range op.Users {
+			in, c := s.chat(user, op.Chat)
+			c.ActiveTime, c.LastID, c.ReadID = op.Time, op.Last, op.ID
+			c.unread = append([]int64(nil), op.IDs...)
+			if op.Time > in.lastActive {
+				in.lastActive = op.Time
+			}
+		}
+	case "read":
+		for _, user := range op.Users {
+			_, c := s.chat(user, op.Chat)
+			c.read(op.ID)
+		}
+	case "join":
+		for _, user := range op.Users {
+			if in, ok := s.users[user]; ok && in.chats[op.Chat] != nil {
+				continue
+			}
+			in, c := s.chat(user, op.Chat)
+			in.touch(c, op.Time)
+		}
+	case "leave":
+		for _, user := range op.Users {
+			if in, ok := s.users[user]; ok {
+				delete(in.chats, op.Chat)
+			}
+		}
+	default:
+		return fmt.Errorf("unknown operation %q", op.Op)
+	}
+	return nil
+}
+
+// chat returns the inbox of user and its chat, creating them if needed.
+func (s *fileInboxStore) chat(user, chat string) (*inbox, *inboxChat) {
+	in, ok := s.users[user]
+	if !ok {
+		in = &inbox{chats: make(map[string]*inboxChat)}
+		s.users[user] = in
+	}
+	c, ok := in.chats[chat]
+	if !ok {
+		c = &inboxChat{InboxEntry: InboxEntry{Chat: chat}}
+		in.chats[chat] = c
+	}
+	return in, c
+}
+
+// touch moves the activity time of c to t, or right after the latest activity
+// of the inbox, so that activity times stay unique.
+func (in *inbox) touch(c *inboxChat, t int64) {
+	if t <= in.lastActive {
+		t = in.lastActive + 1
+	}
+	c.ActiveTime, in.lastActive = t, t
+}
+
+// read moves the read position of c forward to id.
+func (c *inboxChat) read(id int64) {
+	if id <= c.ReadID {
+		return
+	}
+	c.ReadID = id
+	i := sort.Search(len(c.unread), func(i int) bool { return c.unread[i] > id })
+	c.unread = append(c.unread[:0], c.unread[i:]...)
+}
+
+// write records ops in the journal, then applies them.
+func (s *fileInboxStore) write(ops ...inboxOp) error {
+	records := make([]interface{}, 0, len(ops))
+	for _, op := range ops {
+		records = append(records, op)
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if err := s.journal.append(records...); err != nil {
+		return err
+	}
+	s.records += len(records)
+	for _, op := range ops {
+		if err := s.apply(op); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// compact rewrites the journal with an "entry" record per chat of every
+// inbox, once most of its records are stale.
+func (s *fileInboxStore) compact() error {
+	n := 0
+	for _, in := range s.users {
+		n += len(in.chats)
+	}
+	if stale := s.records - n; stale < fileRewriteMin || stale < n {
+		return nil
+	}
+	entries := make([]interface{}, 0, n)
+	for user, in := range s.users {
+		for _, c := range in.chats {
+			entries = append(entries, inboxOp{
+				Op:    "entry",
+				Chat:  c.Chat,
+				Users: []string{user},
+				ID:    c.ReadID,
+				Last:  c.LastID,
+				Time:  c.ActiveTime,
+				IDs:   c.unread,
+			})
+		}
+	}
+	if err := s.journal.rewrite(entries...); err != nil {
+		return err
+	}
+	s.records = len(entries)
+	return nil
+}
+
+func (s *fileInboxStore) Post(ctx context.Context, posts []InboxPost) error {
+	ops := make([]inboxOp, 0, len(posts))
+	for _, p := range posts {
+		msg := p.Message
+		ops = append(ops, inboxOp{Op: "post", Chat: msg.Chat, Users: p.Members, Sender: msg.Sender, ID: msg.ID, Time: msg.SendTime})
+	}
+	return s.write(ops...)
+}
+
+func (s *fileInboxStore) Update(ctx context.Context, msg *rpc.Message, members []string) error {
+	if !msg.Deleted {
+		return nil
+	}
+	if err := s.write(inboxOp{Op: "delete", Chat: msg.Chat, Users: members, ID: msg.ID}); err != nil {
+		return err
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.compact()
+}
+
+func (s *fileInboxStore) Purge(ctx context.Context, chat string, ids []int64, last int64) error {
+	if len(ids) == 0 {
+		return nil
+	}
+	if err := s.write(inboxOp{Op: "purge", Chat: chat, IDs: ids, Last: last}); err != nil {
+		return err
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.compact()
+}
+
+func (s *fileInboxStore) MarkRead(ctx context.Context, chat, user string, id int64) error {
+	return s.write(inboxOp{Op: "read", Chat: chat, Users: []string{user}, ID: id})
+}
+
+func (s *fileInboxStore) ReadPositions(ctx context.Context, chat string, users []string) ([]int64, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	ids := make([]int64, 0, len(users))
+	for _, user := range users {
+		var id int64
+		if in, ok := s.users[user]; ok && in.chats[chat] != nil {
+			id = in.chats[chat].ReadID
+		}
+		ids = append(ids, id)
+	}
+	return ids, nil
+}
+
+func (s *fileInboxStore) Join(ctx context.Context, chat string, users []string) error {
+	return s.write(inboxOp{Op: "join", Chat: chat, Users: users, Time: time.Now().UnixMicro()})
+}
+
+func (s *fileInboxStore) Leave(ctx context.Context, chat string, users []string) error {
+	return s.write(inboxOp{Op: "leave", Chat: chat, Users: users})
+}
+
+func (s *fileInboxStore) List(ctx context.Context, user string, cursor int64, limit int) ([]*InboxEntry, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	in, ok := s.users[user]
+	if !ok {
+		return nil, nil
+	}
+	chats := make([]*inboxChat, 0, len(in.chats))
+	for _, c := range in.chats {
+		if cursor <= 0 || c.ActiveTime <= cursor {
+			chats = append(chats, c)
+		}
+	}
+	sort.Slice(chats, func(i, j int) bool { return chats[i].ActiveTime > chats[j].ActiveTime })
+	if limit > 0 && len(chats) > limit {
+		chats = chats[:limit]
+	}
+	entries := make([]*InboxEntry, 0, len(chats))
+	for _, c := range chats {
+		e := c.InboxEntry
+		e.Unread = int64(len(c.unread))
+		entries = append(entries, &e)
+	}
+	return entries, nil
+}
+
+func (s *fileInboxStore) Close() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.journal.Close()
+}
@@ -0,0 +1,196 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/TikTokTechImmersion/assignment_demo_2023/rpc-server/kitex_gen/rpc"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFileInboxStore(t *testing.T) {
+	ctx := context.Background()
+	path := filepath.Join(t.TempDir(), "inbox.log")
+	store, err := OpenFileInboxStore(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.NoError(t, store.Post(ctx, []InboxPost{
+		{Message: &rpc.Message{Chat: "a:b", Sender: "a", Text: "1", ID: 1, SendTime: 10}, Members: []string{"a", "b"}},
+		{Message: &rpc.Message{Chat: "a:c", Sender: "c", Text: "x", ID: 1, SendTime: 10}, Members: []string{"a", "c"}},
+		{Message: &rpc.Message{Chat: "a:b", Sender: "b", Text: "3", ID: 3, SendTime: 30}, Members: []string{"a", "b"}},
+		// Recorded after the later message of its chat.
+		{Message: &rpc.Message{Chat: "a:b", Sender: "b", Text: "2", ID: 2, SendTime: 20}, Members: []string{"a", "b"}},
+	}))
+	assert.NoError(t, store.MarkRead(ctx, "a:c", "c", 1))
+	assert.NoError(t, store.Join(ctx, "group-1", []string{"a", "b"}))
+	assert.NoError(t, store.Leave(ctx, "group-1", []string{"b"}))
+	assert.NoError(t, store.Close())
+
+	store, err = OpenFileInboxStore(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer store.Close()
+	entries, err := store.List(ctx, "a", 0, 0)
+	assert.NoError(t, err)
+	assert.Len(t, entries, 3)
+	assert.Equal(t, "group-1", entries[0].Chat)
+	assert.Zero(t, entries[0].LastID)
+	assert.Equal(t, "a:b", entries[1].Chat)
+	assert.Equal(t, int64(3), entries[1].LastID)
+	assert.Equal(t, int64(2), entries[1].Unread)
+	assert.Equal(t, "a:c", entries[2].Chat)
+	assert.Equal(t, int64(1), entries[2].Unread)
+	// Activity times stay unique even though both chats were active at 10.
+	assert.Greater(t, entries[1].ActiveTime, entries[2].ActiveTime)
+
+	entries, err = store.List(ctx, "a", entries[1].ActiveTime, 1)
+	assert.NoError(t, err)
+	assert.Len(t, entries, 1)
+	assert.Equal(t, "a:b", entries[0].Chat)
+
+	// Sending a message reads the chat up to it.
+	entries, err = store.List(ctx, "b", 0, 0)
+	assert.NoError(t, err)
+	assert.Len(t, entries, 1)
+	assert.Equal(t, int64(0), entries[0].Unread)
+	assert.Equal(t, int64(3), entries[0].ReadID)
+	entries, err = store.List(ctx, "d", 0, 0)
+	assert.NoError(t, err)
+	assert.Empty(t, entries)
+}
+
+func TestFileInboxStore_MarkRead(t *testing.T) {
+	ctx := context.Background()
+	store, err := OpenFileInboxStore(filepath.Join(t.TempDir(), "inbox.log"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer store.Close()
+	members := []string{"a", "b"}
+	post := func(id int64) InboxPost {
+		return InboxPost{Message: &rpc.Message{Chat: "a:b", Sender: "a", ID: id, SendTime: id}, Members: members}
+	}
+	unread := func() int64 {
+		entries, err := store.List(ctx, "b", 0, 0)
+		assert.NoError(t, err)
+		return entries[0].Unread
+	}
+	assert.NoError(t, store.Post(ctx, []InboxPost{post(1), post(2), post(4)}))
+	assert.Equal(t, int64(3), unread())
+	assert.NoError(t, store.MarkRead(ctx, "a:b", "b", 2))
+	assert.Equal(t, int64(1), unread())
+	// Reads never move back, and messages posted late behind them stay read.
+	assert.NoError(t, store.MarkRead(ctx, "a:b", "b", 1))
+	assert.NoError(t, store.Post(ctx, []InboxPost{post(3), post(5)}))
+	assert.Equal(t, int64(3), unread())
+	assert.NoError(t, store.MarkRead(ctx, "a:b", "b", 4))
+	assert.Equal(t, int64(1), unread())
+
+	ids, err := store.ReadPositions(ctx, "a:b", []string{"a", "b", "c"})
+	assert.NoError(t, err)
+	assert.Equal(t, []int64{5, 4, 0}, ids)
+}
+
+func TestFileInboxStore_Purge(t *testing.T) {
+	ctx := context.Background()
+	path := filepath.Join(t.TempDir(), "inbox.log")
+	store, err := OpenFileInboxStore(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	members := []string{"a", "b"}
+	msgs := []*rpc.Message{
+		{Chat: "a:b", Sender: "a", Text: "hi", ID: 1, SendTime: 10},
+		{Chat: "a:b", Sender: "a", Text: "secret 2", ID: 2, SendTime: 20},
+		{Chat: "a:b", Sender: "a", Text: "secret 3", ID: 3, SendTime: 30},
+		{Chat: "a:b", Sender: "a", Text: "deleted 4", ID: 4, SendTime: 40},
+	}
+	for _, msg := range msgs {
+		assert.NoError(t, store.Post(ctx, []InboxPost{{Message: msg, Members: members}}))
+	}
+	assert.NoError(t, store.Join(ctx, "b:c", []string{"b", "c"}))
+	assert.NoError(t, store.Update(ctx, &rpc.Message{Chat: "a:b", Sender: "a", ID: 4, SendTime: 40, Deleted: true}, members))
+	assert.NoError(t, store.Purge(ctx, "a:b", []int64{2, 3, 4}, 1))
+
+	// Messages are recorded by ID, their text is left to the MessageStore.
+	data, err := os.ReadFile(path)
+	assert.NoError(t, err)
+	assert.NotContains(t, string(data), "secret")
+	assert.NotContains(t, string(data), "deleted")
+
+	assert.NoError(t, store.Close())
+	store, err = OpenFileInboxStore(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer store.Close()
+	entries, err := store.List(ctx, "b", 0, 0)
+	assert.NoError(t, err)
+	assert.Len(t, entries, 2)
+	assert.Equal(t, "b:c", entries[0].Chat)
+	assert.Equal(t, "a:b", entries[1].Chat)
+	assert.Equal(t, int64(1), entries[1].LastID)
+	assert.Equal(t, int64(1), entries[1].Unread)
+	ids, err := store.ReadPositions(ctx, "a:b", members)
+	assert.NoError(t, err)
+	assert.Equal(t, []int64{4, 0}, ids)
+
+	// Activity times stay unique after a restart.
+	assert.NoError(t, store.Join(ctx, "b:d", []string{"b"}))
+	entries, err = store.List(ctx, "b", 0, 0)
+	assert.NoError(t, err)
+	assert.Equal(t, "b:d", entries[0].Chat)
+	assert.Greater(t, entries[0].ActiveTime, entries[1].ActiveTime)
+
+	// Purging every message leaves no last message.
+	assert.NoError(t, store.Purge(ctx, "a:b", []int64{1}, 0))
+	entries, err = store.List(ctx, "a", 0, 0)
+	assert.NoError(t, err)
+	assert.Zero(t, entries[0].LastID)
+	assert.Equal(t, int64(0), entries[0].Unread)
+}
+
+func TestFileInboxStore_Compact(t *testing.T) {
+	ctx := context.Background()
+	path := filepath.Join(t.TempDir(), "inbox.log")
+	store, err := OpenFileInboxStore(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	members := []string{"a", "b"}
+	var posts []InboxPost
+	for id := int64(1); id <= fileRewriteMin; id++ {
+		posts = append(posts, InboxPost{Message: &rpc.Message{Chat: "a:b", Sender: "a", ID: id, SendTime: id}, Members: members})
+	}
+	assert.NoError(t, store.Post(ctx, posts))
+	assert.NoError(t, store.MarkRead(ctx, "a:b", "b", 1000))
+	lines := func() int {
+		data, err := os.ReadFile(path)
+		assert.NoError(t, err)
+		return bytes.Count(data, []byte("\n"))
+	}
+	assert.Equal(t, fileRewriteMin+1, lines())
+
+	// The journal is rewritten with an entry per chat of every inbox once
+	// most of its records are stale.
+	assert.NoError(t, store.Update(ctx, &rpc.Message{Chat: "a:b", Sender: "a", ID: 1002, SendTime: 1002, Deleted: true}, members))
+	assert.Equal(t, 2, lines())
+	assert.NoError(t, store.Close())
+	store, err = OpenFileInboxStore(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer store.Close()
+	entries, err := store.List(ctx, "b", 0, 0)
+	assert.NoError(t, err)
+	if assert.Len(t, entries, 1) {
+		assert.Equal(t, int64(fileRewriteMin), entries[0].LastID)
+		assert.Equal(t, int64(1000), entries[0].ReadID)
+		assert.Equal(t, int64(fileRewriteMin-1001), entries[0].Unread)
+	}
+}
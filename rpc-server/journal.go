@@ -0,0 +1,117 @@
+package main
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+)
+
+// journal is an append-only log of JSON records on local disk, one per line.
+// It backs the embedded stores, which replay it into memory when opened.
+type journal struct {
+	path string
+	f    *os.File
+}
+
+// openJournal opens the journal at path, creating it if needed, and calls
+// replay with every record already in it. A torn last record, left behind by
+// a crash in the middle of a write, is truncated away.
+func openJournal(path string, replay func(line []byte) error) (*journal, error) {
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return nil, err
+	}
+	f, err := os.OpenFile(path, os.O_CREATE|os.O_RDWR, 0o644)
+	if err != nil {
+		return nil, err
+	}
+	offset, err := replayJournal(f, replay)
+	if err != nil {
+		f.Close()
+		return nil, fmt.Errorf("journal %s: %w", path, err)
+	}
+	if _, err := f.Seek(offset, io.SeekStart); err != nil {
+		f.Close()
+		return nil, err
+	}
+	return &journal{path: path, f: f}, nil
+}
+
+func replayJournal(f *os.File, replay func(line []byte) error) (int64, error) {
+	r := bufio.NewReader(f)
+	var offset int64
+	for {
+		line, err := r.ReadBytes('\n')
+		if err == io.EOF {
+			if len(line) > 0 {
+				return offset, f.Truncate(offset)
+			}
+			return offset, nil
+		} else if err != nil {
+			return offset, err
+		}
+		if err := replay(line); err != nil {
+			return offset, fmt.Errorf("corrupted record at offset %d: %w", offset, err)
+		}
+		offset += int64(len(line))
+	}
+}
+
+// append durably writes vs as the next records of the journal, with a
+// single sync. Callers serialise calls to append.
+func (j *journal) append(vs ...interface{}) error {
+	var buf []byte
+	for _, v := range vs {
+		line, err := json.Marshal(v)
+		if err != nil {
+			return err
+		}
+		buf = append(append(buf, line...), '\n')
+	}
+	if _, err := j.f.Write(buf); err != nil {
+		return err
+	}
+	return j.f.Sync()
+}
+
+// rewrite atomically replaces the records of the journal by vs, dropping the
+// records the store no longer needs. Callers serialise calls to rewrite with
+// calls to append.
+func (j *journal) rewrite(vs ...interface{}) error {
+	tmp := j.path + ".tmp"
+	f, err := os.OpenFile(tmp, os.O_CREATE|os.O_TRUNC|os.O_WRONLY|os.O_APPEND, 0o644)
+	if err != nil {
+		return err
+	}
+	w := bufio.NewWriter(f)
+	enc := json.NewEncoder(w)
+	for _, v := range vs {
+		if err = enc.Encode(v); err != nil {
+			break
+		}
+	}
+	if err == nil {
+		err = w.Flush()
+	}
+	if err == nil {
+		err = f.Sync()
+	}
+	if err == nil {
+		// The new file stays open for the next appends.
+		err = os.Rename(tmp, j.path)
+	}
+	if err != nil {
+		f.Close()
+		os.Remove(tmp)
+		return err
+	}
+	j.f.Close()
+	j.f = f
+	return nil
+}
+
+func (j *journal) Close() error {
+	return j.f.Close()
+}
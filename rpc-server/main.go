@@ -1,12 +1,24 @@
 package main
 
 import (
+	"context"
+	"database/sql"
+	"fmt"
 	"log"
+	"net"
+	"net/http"
+	"os"
+	"strconv"
+	"strings"
+	"time"
 
+	"github.com/TikTokTechImmersion/assignment_demo_2023/rpc-server/kitex_gen/rpc/authservice"
 	rpc "github.com/TikTokTechImmersion/assignment_demo_2023/rpc-server/kitex_gen/rpc/imservice"
 	"github.com/cloudwego/kitex/pkg/rpcinfo"
 	"github.com/cloudwego/kitex/server"
+	_ "github.com/go-sql-driver/mysql"
 	etcd "github.com/kitex-contrib/registry-etcd"
+	"github.com/redis/go-redis/v9"
 )
 
 func main() {
@@ -15,7 +27,114 @@ func main() {
 		log.Fatal(err)
 	}
 
-	svr := rpc.NewServer(new(IMServiceImpl), server.WithRegistry(r), server.WithServerBasicInfo(&rpcinfo.EndpointBasicInfo{
+	if err := checkReplicas(); err != nil {
+		log.Fatal(err)
+	}
+	store, err := openMessageStore()
+	if err != nil {
+		log.Fatal(err)
+	}
+	defer store.Close()
+
+	members, err := OpenFileMemberStore(getenv("MEMBER_LOG", "data/members.log"))
+	if err != nil {
+		log.Fatal(err)
+	}
+	defer members.Close()
+
+	inbox, err := OpenFileInboxStore(getenv("INBOX_LOG", "data/inbox.log"))
+	if err != nil {
+		log.Fatal(err)
+	}
+	defer inbox.Close()
+
+	search, err := OpenFileSearchIndex(getenv("SEARCH_LOG", "data/search.log"))
+	if err != nil {
+		log.Fatal(err)
+	}
+	defer search.Close()
+
+	retention, err := OpenFileRetentionStore(getenv("RETENTION_LOG", "data/retention.log"))
+	if err != nil {
+		log.Fatal(err)
+	}
+	defer retention.Close()
+
+	blobs, err := OpenFileBlobRefStore(getenv("BLOB_LOG", "data/blobs.log"))
+	if err != nil {
+		log.Fatal(err)
+	}
+	defer blobs.Close()
+
+	interval, err := time.ParseDuration(getenv("RETENTION_INTERVAL", "1m"))
+	if err != nil || interval <= 0 {
+		log.Fatalf("invalid RETENTION_INTERVAL %q", os.Getenv("RETENTION_INTERVAL"))
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go (&compactor{store: store, retention: retention, inbox: inbox, search: search, blobs: blobs}).run(ctx, interval)
+
+	// Metrics are published by expvar at /debug/vars, along with the other
+	// handlers of the default mux, only on the loopback interface unless
+	// configured otherwise.
+	if addr := getenv("METRICS_ADDR", "127.0.0.1:9090"); addr != "" {
+		go func() {
+			log.Println(http.ListenAndServe(addr, nil))
+		}()
+	}
+
+	users, err := OpenFileUserStore(getenv("USER_LOG", "data/users.log"))
+	if err != nil {
+		log.Fatal(err)
+	}
+	defer users.Close()
+
+	sessions, err := OpenFileSessionStore(getenv("SESSION_LOG", "data/sessions.log"))
+	if err != nil {
+		log.Fatal(err)
+	}
+	defer sessions.Close()
+
+	key, err := loadTokenKey(getenv("AUTH_KEY_FILE", "data/auth-key.pem"))
+	if err != nil {
+		log.Fatal(err)
+	}
+	accessTTL, err := time.ParseDuration(getenv("ACCESS_TOKEN_TTL", "15m"))
+	if err != nil || accessTTL <= 0 {
+		log.Fatalf("invalid ACCESS_TOKEN_TTL %q", os.Getenv("ACCESS_TOKEN_TTL"))
+	}
+	refreshTTL, err := time.ParseDuration(getenv("REFRESH_TOKEN_TTL", "720h"))
+	if err != nil || refreshTTL <= 0 {
+		log.Fatalf("invalid REFRESH_TOKEN_TTL %q", os.Getenv("REFRESH_TOKEN_TTL"))
+	}
+	authImpl, err := NewAuthServiceImpl(users, sessions, newTokenIssuer(key, os.Getenv("JWT_ISSUER"), accessTTL), refreshTTL)
+	if err != nil {
+		log.Fatal(err)
+	}
+	authAddr, err := net.ResolveTCPAddr("tcp", getenv("AUTH_ADDR", ":8889"))
+	if err != nil {
+		log.Fatalf("invalid AUTH_ADDR: %v", err)
+	}
+	authRegistry, err := etcd.NewEtcdRegistry([]string{"etcd:2379"})
+	if err != nil {
+		log.Fatal(err)
+	}
+	authSvr := authservice.NewServer(authImpl, server.WithServiceAddr(authAddr), server.WithRegistry(authRegistry),
+		server.WithServerBasicInfo(&rpcinfo.EndpointBasicInfo{
+			ServiceName: "demo.auth.server",
+		}))
+	go func() {
+		if err := authSvr.Run(); err != nil {
+			log.Println(err.Error())
+		}
+	}()
+
+	limits, err := openRateLimits()
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	svr := rpc.NewServer(NewIMServiceImpl(store, members, inbox, search, retention, blobs, limits), server.WithRegistry(r), server.WithServerBasicInfo(&rpcinfo.EndpointBasicInfo{
 		ServiceName: "demo.rpc.server",
 	}))
 
@@ -24,3 +143,109 @@ func main() {
 		log.Println(err.Error())
 	}
 }
+
+// checkReplicas fails unless the RPC_REPLICAS environment variable declares a
+// single rpc-server. Whatever MESSAGE_STORE is, members, inboxes, the search
+// index, retention policies, blob references, users and sessions are kept in
+// local files, and idempotency keys in memory, which replicas would not share.
+// RPC_REPLICAS may only be left unset with the file MESSAGE_STORE, which is
+// local too, so that deployments moving messages to a shared store have to
+// acknowledge that the rest is not.
+func checkReplicas() error {
+	replicas, kind := os.Getenv("RPC_REPLICAS"), getenv("MESSAGE_STORE", "file")
+	switch {
+	case replicas == "1", replicas == "" && kind == "file":
+		return nil
+	case replicas == "":
+		return fmt.Errorf("RPC_REPLICAS must be set to 1 with MESSAGE_STORE %q, the other state of the rpc-server is local to each replica", kind)
+	default:
+		return fmt.Errorf("unsupported RPC_REPLICAS %q, the state of the rpc-server besides messages is local to each replica", replicas)
+	}
+}
+
+// openMessageStore opens the MessageStore selected by the MESSAGE_STORE
+// environment variable, one of "file" (the default), "redis" or "mysql".
+func openMessageStore() (MessageStore, error) {
+	switch kind := getenv("MESSAGE_STORE", "file"); kind {
+	case "file":
+		return OpenFileStore(getenv("MESSAGE_LOG", "data/messages.log"))
+	case "redis":
+		rdb, err := newRedisClient()
+		if err != nil {
+			return nil, err
+		}
+		return NewRedisStore(rdb), nil
+	case "mysql":
+		db, err := sql.Open("mysql", getenv("MYSQL_DSN", "root@tcp(mysql:3306)/im"))
+		if err != nil {
+			return nil, err
+		}
+		store, err := OpenSQLStore(context.Background(), db)
+		if err != nil {
+			db.Close()
+			return nil, err
+		}
+		return store, nil
+	default:
+		return nil, fmt.Errorf("unknown MESSAGE_STORE %q", kind)
+	}
+}
+
+// openRateLimits returns the rate limits configured by the SEND_LIMIT_PER_USER,
+// SEND_LIMIT_PER_CHAT, PULL_LIMIT_PER_USER and PULL_LIMIT_PER_CHAT
+// environment variables, kept by the RateLimiter selected by
+// RATE_LIMIT_STORE, one of "local" (the default) or "redis". Only the
+// "redis" limiter enforces the limits across rpc-server replicas.
+func openRateLimits() (*rateLimits, error) {
+	limits := new(rateLimits)
+	for _, l := range []struct {
+		env, def string
+		limit    *Limit
+	}{
+		{"SEND_LIMIT_PER_USER", "20/1s", &limits.sendUser},
+		{"SEND_LIMIT_PER_CHAT", "100/1s", &limits.sendChat},
+		{"PULL_LIMIT_PER_USER", "50/1s", &limits.pullUser},
+		{"PULL_LIMIT_PER_CHAT", "200/1s", &limits.pullChat},
+	} {
+		limit, err := parseLimit(getenv(l.env, l.def))
+		if err != nil {
+			return nil, fmt.Errorf("invalid %s: %w", l.env, err)
+		}
+		*l.limit = limit
+	}
+	switch kind := getenv("RATE_LIMIT_STORE", "local"); kind {
+	case "local":
+		limits.limiter = newLocalLimiter()
+	case "redis":
+		rdb, err := newRedisClient()
+		if err != nil {
+			return nil, err
+		}
+		limits.limiter = NewRedisLimiter(rdb)
+	default:
+		return nil, fmt.Errorf("unknown RATE_LIMIT_STORE %q", kind)
+	}
+	return limits, nil
+}
+
+// newRedisClient returns a client of the Redis configured by the REDIS_ADDR,
+// REDIS_PASSWORD and REDIS_DB environment variables.
+func newRedisClient() (redis.UniversalClient, error) {
+	db, err := strconv.Atoi(getenv("REDIS_DB", "0"))
+	if err != nil {
+		return nil, fmt.Errorf("invalid REDIS_DB: %w", err)
+	}
+	return redis.NewUniversalClient(&redis.UniversalOptions{
+		Addrs:    strings.Split(getenv("REDIS_ADDR", "redis:6379"), ","),
+		Password: os.Getenv("REDIS_PASSWORD"),
+		DB:       db,
+	}), nil
+}
+
+// getenv returns the value of the environment variable key, or def if unset.
+func getenv(key, def string) string {
+	if v, ok := os.LookupEnv(key); ok {
+		return v
+	}
+	return def
+}
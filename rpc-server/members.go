@@ -0,0 +1,28 @@
+package main
+
+import (
+	"context"
+	"errors"
+)
+
+var (
+	errChatNotFound = errors.New("chat not found")
+	errChatExists   = errors.New("chat already exists")
+)
+
+// MemberStore keeps the members of group chats. Direct chats between two
+// members are not stored, their members are encoded in the chat identifier.
+type MemberStore interface {
+	// CreateChat creates a group chat with the given members.
+	// It returns errChatExists if chat was already created.
+	CreateChat(ctx context.Context, chat string, members []string) error
+	// AddMembers adds members to chat, ignoring those already in it.
+	AddMembers(ctx context.Context, chat string, members []string) error
+	// RemoveMembers removes members from chat, ignoring those not in it.
+	RemoveMembers(ctx context.Context, chat string, members []string) error
+	// Members returns the members of chat in the order they joined it.
+	// It returns errChatNotFound if chat does not exist.
+	Members(ctx context.Context, chat string) ([]string, error)
+	// Close releases the resources held by the store.
+	Close() error
+}
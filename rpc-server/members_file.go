@@ -0,0 +1,141 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sync"
+)
+
+// memberOp is a record of the member journal.
+type memberOp struct {
+	Op      string // one of "create", "add" or "remove"
+	Chat    string
+	Members []string
+}
+
+// fileMemberStore is a MemberStore backed by an append-only journal of
+// membership changes on local disk, replayed into memory when opened.
+type fileMemberStore struct {
+	mu      sync.RWMutex
+	journal *journal
+	chats   map[string][]string
+}
+
+// OpenFileMemberStore opens the member journal at path, creating it if needed.
+func OpenFileMemberStore(path string) (MemberStore, error) {
+	s := &fileMemberStore{chats: make(map[string][]string)}
+	j, err := openJournal(path, func(line []byte) error {
+		var op memberOp
+		if err := json.Unmarshal(line, &op); err != nil {
+			return err
+		}
+		return s.apply(op)
+	})
+	if err != nil {
+		return nil, err
+	}
+	s.journal = j
+	return s, nil
+}
+
+func (s *fileMemberStore) apply(op memberOp) error {
+	members, ok := s.chats[op.Chat]
+	switch {
+	case op.Op == "create" && ok:
+		return errChatExists
+	case op.Op != "create" && !ok:
+		return errChatNotFound
+	}
+	switch op.Op {
+	case "create":
+		s.chats[op.Chat] = addMembers(nil, op.Members)
+	case "add":
+		s.chats[op.Chat] = addMembers(members, op.Members)
+	case "remove":
+		s.chats[op.Chat] = removeMembers(members, op.Members)
+	default:
+		return fmt.Errorf("unknown operation %q", op.Op)
+	}
+	return nil
+}
+
+// write applies op and records it in the journal if it is valid.
+func (s *fileMemberStore) write(op memberOp) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	prev, existed := s.chats[op.Chat]
+	if err := s.apply(op); err != nil {
+		return err
+	}
+	if err := s.journal.append(op); err != nil {
+		if existed {
+			s.chats[op.Chat] = prev
+		} else {
+			delete(s.chats, op.Chat)
+		}
+		return err
+	}
+	return nil
+}
+
+func (s *fileMemberStore) CreateChat(ctx context.Context, chat string, members []string) error {
+	return s.write(memberOp{Op: "create", Chat: chat, Members: members})
+}
+
+func (s *fileMemberStore) AddMembers(ctx context.Context, chat string, members []string) error {
+	return s.write(memberOp{Op: "add", Chat: chat, Members: members})
+}
+
+func (s *fileMemberStore) RemoveMembers(ctx context.Context, chat string, members []string) error {
+	return s.write(memberOp{Op: "remove", Chat: chat, Members: members})
+}
+
+func (s *fileMemberStore) Members(ctx context.Context, chat string) ([]string, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	members, ok := s.chats[chat]
+	if !ok {
+		return nil, errChatNotFound
+	}
+	return append([]string(nil), members...), nil
+}
+
+func (s *fileMemberStore) Close() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.journal.Close()
+}
+
+// addMembers returns members with every user of add not already in it
+// appended. The result never shares memory with members.
+func addMembers(members, add []string) []string {
+	out := append(make([]string, 0, len(members)+len(add)), members...)
+	for _, user := range add {
+		if !containsString(out, user) {
+			out = append(out, user)
+		}
+	}
+	return out
+}
+
+// removeMembers returns members without the users of remove. The result never
+// shares memory with members.
+func removeMembers(members, remove []string) []string {
+	out := make([]string, 0, len(members))
+	for _, user := range members {
+		if !containsString(remove, user) {
+			out = append(out, user)
+		}
+	}
+	return out
+}
+
+func containsString(list []string, s string) bool {
+	for _, v := range list {
+		if v == s {
+			return true
+		}
+	}
+	return false
+}
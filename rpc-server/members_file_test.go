@@ -0,0 +1,35 @@
+package main
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFileMemberStore(t *testing.T) {
+	ctx := context.Background()
+	path := filepath.Join(t.TempDir(), "members.log")
+	store, err := OpenFileMemberStore(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.NoError(t, store.CreateChat(ctx, "group-1", []string{"a", "b"}))
+	assert.ErrorIs(t, store.CreateChat(ctx, "group-1", []string{"c"}), errChatExists)
+	assert.NoError(t, store.AddMembers(ctx, "group-1", []string{"b", "c", "d"}))
+	assert.NoError(t, store.RemoveMembers(ctx, "group-1", []string{"a", "x"}))
+	assert.ErrorIs(t, store.AddMembers(ctx, "group-2", []string{"a"}), errChatNotFound)
+	assert.NoError(t, store.Close())
+
+	store, err = OpenFileMemberStore(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer store.Close()
+	members, err := store.Members(ctx, "group-1")
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"b", "c", "d"}, members)
+	_, err = store.Members(ctx, "group-2")
+	assert.ErrorIs(t, err, errChatNotFound)
+}
@@ -0,0 +1,52 @@
+package main
+
+import "sync"
+
+// notifier wakes up the Pull calls waiting for new messages in a chat.
+// Notifications only reach the waiters of the same rpc-server process.
+type notifier struct {
+	mu    sync.Mutex
+	chats map[string]*chatWaiters
+}
+
+// chatWaiters is the broadcast channel of a chat, closed by the next notify,
+// and the number of waiters holding it.
+type chatWaiters struct {
+	ch chan struct{}
+	n  int
+}
+
+func newNotifier() *notifier {
+	return &notifier{chats: make(map[string]*chatWaiters)}
+}
+
+// subscribe returns a channel closed when a message is next sent to chat, and
+// a function to call once the caller stops waiting on the channel.
+func (n *notifier) subscribe(chat string) (<-chan struct{}, func()) {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	w, ok := n.chats[chat]
+	if !ok {
+		w = &chatWaiters{ch: make(chan struct{})}
+		n.chats[chat] = w
+	}
+	w.n++
+	return w.ch, func() {
+		n.mu.Lock()
+		defer n.mu.Unlock()
+		w.n--
+		if w.n == 0 && n.chats[chat] == w {
+			delete(n.chats, chat)
+		}
+	}
+}
+
+// notify wakes up every waiter of chat.
+func (n *notifier) notify(chat string) {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	if w, ok := n.chats[chat]; ok {
+		close(w.ch)
+		delete(n.chats, chat)
+	}
+}
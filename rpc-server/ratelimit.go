@@ -0,0 +1,185 @@
+package main
+
+import (
+	"context"
+	"expvar"
+	"fmt"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/cloudwego/kitex/pkg/klog"
+)
+
+// rateLimitMetrics count the requests rejected by rate limits, and the
+// failures of the limiter, published by expvar as "ratelimit".
+var rateLimitMetrics = expvar.NewMap("ratelimit")
+
+// Limit is a token bucket holding up to Burst tokens and refilled with Burst
+// tokens every Per. Every request takes a token. The zero Limit is unlimited.
+type Limit struct {
+	Burst int
+	Per   time.Duration
+}
+
+// parseLimit parses a limit of the form "<burst>/<per>", e.g. "10/1s" for up
+// to 10 requests at once and 10 a second on average. The empty string is
+// unlimited.
+func parseLimit(s string) (Limit, error) {
+	if s == "" {
+		return Limit{}, nil
+	}
+	burst, per, ok := strings.Cut(s, "/")
+	n, err := strconv.Atoi(burst)
+	if !ok || err != nil || n <= 0 {
+		return Limit{}, fmt.Errorf("invalid limit %q, expected format \"<requests>/<duration>\"", s)
+	}
+	d, err := time.ParseDuration(per)
+	if err != nil || d <= 0 {
+		return Limit{}, fmt.Errorf("invalid limit %q, expected format \"<requests>/<duration>\"", s)
+	}
+	return Limit{Burst: n, Per: d}, nil
+}
+
+// interval returns how long refilling a single token takes.
+func (l Limit) interval() time.Duration {
+	if d := l.Per / time.Duration(l.Burst); d > 0 {
+		return d
+	}
+	return 1
+}
+
+// RateLimiter takes tokens from token buckets. Implementations must be safe
+// for concurrent use.
+type RateLimiter interface {
+	// Take takes a token from the bucket of key, of capacity and refill rate
+	// limit. If the bucket is empty, it takes none and returns how long until
+	// it holds a token again.
+	Take(ctx context.Context, key string, limit Limit) (time.Duration, error)
+}
+
+// localLimiterSweep is how many takes a localLimiter makes between sweeps of
+// its full buckets.
+const localLimiterSweep = 1024
+
+// localLimiter is a RateLimiter keeping the buckets of a single process.
+// Buckets are kept as the time at which they are full again, as in the
+// generic cell rate algorithm, so that full buckets can be forgotten.
+type localLimiter struct {
+	mu    sync.Mutex
+	full  map[string]time.Time // by bucket key, only the buckets not full
+	takes int
+}
+
+// newLocalLimiter returns an empty localLimiter.
+func newLocalLimiter() *localLimiter {
+	return &localLimiter{full: make(map[string]time.Time)}
+}
+
+func (l *localLimiter) Take(_ context.Context, key string, limit Limit) (time.Duration, error) {
+	return l.take(key, limit, time.Now()), nil
+}
+
+// take takes a token from the bucket of key at now.
+func (l *localLimiter) take(key string, limit Limit, now time.Time) time.Duration {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if l.takes++; l.takes%localLimiterSweep == 0 {
+		for k, full := range l.full {
+			if !full.After(now) {
+				delete(l.full, k)
+			}
+		}
+	}
+	full := l.full[key]
+	if full.Before(now) {
+		full = now
+	}
+	// Taking a token delays the bucket being full by the time to refill it,
+	// which cannot be more than refilling it from empty.
+	full = full.Add(limit.interval())
+	if wait := full.Sub(now) - limit.Per; wait > 0 {
+		return wait
+	}
+	l.full[key] = full
+	return 0
+}
+
+// rateLimits are the limits of the requests of every user and to every chat.
+type rateLimits struct {
+	limiter  RateLimiter
+	sendUser Limit // messages sent by a user
+	sendChat Limit // messages sent to a chat
+	pullUser Limit // pulls by a user
+	pullChat Limit // pulls of a chat
+}
+
+// bucket is a token bucket of rateLimits: the one of id among the buckets of
+// kind, described by what in errors.
+type bucket struct {
+	kind  string
+	id    string
+	limit Limit
+	what  string
+}
+
+// send takes a token from the buckets of user and chat for sending a message,
+// and returns how long to wait and why if one of them is empty.
+func (l *rateLimits) send(ctx context.Context, user, chat string) (time.Duration, string) {
+	if l == nil {
+		return 0, ""
+	}
+	return l.take(ctx,
+		bucket{"send_user", user, l.sendUser, fmt.Sprintf("user %q sends too many messages", user)},
+		bucket{"send_chat", chat, l.sendChat, fmt.Sprintf("chat %q receives too many messages", chat)})
+}
+
+// pull takes a token from the bucket of user, and from the one of chat if
+// set, for pulling messages, and returns how long to wait and why if one of
+// them is empty.
+func (l *rateLimits) pull(ctx context.Context, user, chat string) (time.Duration, string) {
+	if l == nil {
+		return 0, ""
+	}
+	buckets := make([]bucket, 0, 2)
+	if user != "" {
+		buckets = append(buckets, bucket{"pull_user", user, l.pullUser, fmt.Sprintf("user %q pulls too often", user)})
+	}
+	if chat != "" {
+		buckets = append(buckets, bucket{"pull_chat", chat, l.pullChat, fmt.Sprintf("chat %q is pulled too often", chat)})
+	}
+	return l.take(ctx, buckets...)
+}
+
+// take takes a token from every bucket in turn, stopping at the first empty
+// one. The tokens taken from the buckets before it are not given back, so
+// rejected requests still count against them. Limiter failures are logged
+// and let requests through, rather than failing all of them while the
+// limiter is unavailable.
+func (l *rateLimits) take(ctx context.Context, buckets ...bucket) (time.Duration, string) {
+	for _, b := range buckets {
+		if b.limit.Burst <= 0 {
+			continue
+		}
+		key := b.kind + ":" + b.id
+		wait, err := l.limiter.Take(ctx, key, b.limit)
+		if err != nil {
+			rateLimitMetrics.Add("errors", 1)
+			klog.CtxErrorf(ctx, "failed to take a token from bucket %q: %v", key, err)
+			continue
+		}
+		if wait > 0 {
+			rateLimitMetrics.Add(b.kind, 1)
+			return wait, b.what
+		}
+	}
+	return 0, ""
+}
+
+// retryAfterMs returns wait in milliseconds, rounded up so that clients do not
+// retry too early.
+func retryAfterMs(wait time.Duration) *int64 {
+	ms := int64((wait + time.Millisecond - 1) / time.Millisecond)
+	return &ms
+}
@@ -0,0 +1,61 @@
+package main
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+)
+
+// redisLimiter is a RateLimiter sharing its buckets between rpc-server
+// replicas through Redis. Each bucket is kept as the time at which it is full
+// again, in microseconds, under the key
+//
+//	ratelimit:<kind>:<id>
+//
+// which expires once the bucket is full. Times are read from the clock of
+// Redis, so that the clocks of the replicas do not need to agree. This needs
+// Redis 5 or later, which replicates the effects of scripts rather than the
+// scripts themselves.
+type redisLimiter struct {
+	rdb redis.UniversalClient
+}
+
+// NewRedisLimiter creates a RateLimiter on top of rdb.
+func NewRedisLimiter(rdb redis.UniversalClient) RateLimiter {
+	return &redisLimiter{rdb: rdb}
+}
+
+// redisTakeScript takes a token from the bucket KEYS[1], whose refill
+// interval and capacity are ARGV[1] and ARGV[2] in microseconds. It returns
+// how long until the bucket holds a token in microseconds, zero if a token
+// was taken.
+var redisTakeScript = redis.NewScript(`
+local time = redis.call("TIME")
+local now = tonumber(time[1]) * 1000000 + tonumber(time[2])
+local full = tonumber(redis.call("GET", KEYS[1]) or now)
+if full < now then
+	full = now
+end
+full = full + tonumber(ARGV[1])
+local wait = full - now - tonumber(ARGV[2])
+if wait > 0 then
+	return wait
+end
+redis.call("SET", KEYS[1], string.format("%.0f", full), "PX", math.ceil((full - now) / 1000))
+return 0
+`)
+
+func (l *redisLimiter) Take(ctx context.Context, key string, limit Limit) (time.Duration, error) {
+	interval := limit.interval().Microseconds()
+	if interval <= 0 {
+		interval = 1
+	}
+	wait, err := redisTakeScript.Run(ctx, l.rdb, []string{"ratelimit:" + key},
+		strconv.FormatInt(interval, 10), strconv.FormatInt(interval*int64(limit.Burst), 10)).Int64()
+	if err != nil {
+		return 0, err
+	}
+	return time.Duration(wait) * time.Microsecond, nil
+}
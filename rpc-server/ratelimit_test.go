@@ -0,0 +1,182 @@
+package main
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/TikTokTechImmersion/assignment_demo_2023/rpc-server/kitex_gen/rpc"
+	"github.com/alicebob/miniredis/v2"
+	"github.com/redis/go-redis/v9"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseLimit(t *testing.T) {
+	tests := []struct {
+		name    string
+		s       string
+		want    Limit
+		wantErr bool
+	}{
+		{name: "unlimited", s: "", want: Limit{}},
+		{name: "per second", s: "10/1s", want: Limit{Burst: 10, Per: time.Second}},
+		{name: "per minute", s: "300/1m", want: Limit{Burst: 300, Per: time.Minute}},
+		{name: "no duration", s: "10", wantErr: true},
+		{name: "no unit", s: "10/1", wantErr: true},
+		{name: "zero burst", s: "0/1s", wantErr: true},
+		{name: "negative duration", s: "10/-1s", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := parseLimit(tt.s)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+// testRateLimiter takes tokens from buckets of 2 tokens a second, with take
+// taking one from the bucket of key after moving the clock by elapsed.
+func testRateLimiter(t *testing.T, take func(t *testing.T, key string, limit Limit, elapsed time.Duration) time.Duration) {
+	limit := Limit{Burst: 2, Per: time.Second}
+	tests := []struct {
+		name    string
+		key     string
+		elapsed time.Duration
+		wait    time.Duration
+	}{
+		{name: "first", key: "a"},
+		{name: "burst", key: "a"},
+		{name: "empty", key: "a", wait: 500 * time.Millisecond},
+		{name: "other bucket", key: "b"},
+		{name: "partly refilled", key: "a", elapsed: 200 * time.Millisecond, wait: 300 * time.Millisecond},
+		{name: "refilled", key: "a", elapsed: 300 * time.Millisecond},
+		{name: "empty again", key: "a", wait: 500 * time.Millisecond},
+		{name: "full", key: "a", elapsed: 2 * time.Second},
+		{name: "burst again", key: "a"},
+		{name: "capacity", key: "a", wait: 500 * time.Millisecond},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.wait, take(t, tt.key, limit, tt.elapsed))
+		})
+	}
+}
+
+func TestLocalLimiter(t *testing.T) {
+	l := newLocalLimiter()
+	now := time.Now()
+	testRateLimiter(t, func(t *testing.T, key string, limit Limit, elapsed time.Duration) time.Duration {
+		now = now.Add(elapsed)
+		return l.take(key, limit, now)
+	})
+
+	// Full buckets are forgotten.
+	for i := 0; i < localLimiterSweep; i++ {
+		l.take("c", Limit{Burst: 1, Per: time.Millisecond}, now.Add(time.Duration(i+10)*time.Second))
+	}
+	assert.Len(t, l.full, 1)
+	assert.Contains(t, l.full, "c")
+}
+
+func TestRedisLimiter(t *testing.T) {
+	ctx := context.Background()
+	mr := miniredis.RunT(t)
+	l := NewRedisLimiter(redis.NewClient(&redis.Options{Addr: mr.Addr()}))
+	now := time.Now()
+	testRateLimiter(t, func(t *testing.T, key string, limit Limit, elapsed time.Duration) time.Duration {
+		now = now.Add(elapsed)
+		mr.SetTime(now)
+		wait, err := l.Take(ctx, key, limit)
+		assert.NoError(t, err)
+		return wait
+	})
+
+	// Buckets expire once full.
+	ttl := mr.TTL("ratelimit:a")
+	assert.True(t, ttl > 0 && ttl <= time.Second, ttl)
+}
+
+func TestIMServiceImpl_RateLimits(t *testing.T) {
+	ctx := context.Background()
+	s := newTestService(t)
+	s.limits = &rateLimits{
+		limiter:  newLocalLimiter(),
+		sendUser: Limit{Burst: 2, Per: time.Minute},
+		sendChat: Limit{Burst: 3, Per: time.Minute},
+		pullUser: Limit{Burst: 1, Per: time.Minute},
+		pullChat: Limit{Burst: 2, Per: time.Minute},
+	}
+	send := func(sender string) *rpc.SendResponse {
+		resp, err := s.Send(ctx, &rpc.SendRequest{Message: &rpc.Message{Chat: "doe:john", Text: "hi", Sender: sender}})
+		assert.NoError(t, err)
+		return resp
+	}
+	assert.Equal(t, int32(0), send("doe").Code)
+	assert.Equal(t, int32(0), send("doe").Code)
+	resp := send("doe")
+	assert.Equal(t, int32(429), resp.Code)
+	assert.Contains(t, resp.Msg, `user "doe"`)
+	assert.InDelta(t, 30000, resp.GetRetryAfterMs(), 1000)
+	assert.Equal(t, int32(0), send("john").Code)
+	resp = send("john")
+	assert.Equal(t, int32(429), resp.Code)
+	assert.Contains(t, resp.Msg, `chat "doe:john"`)
+
+	// Every message of a batch is limited on its own.
+	batch, err := s.BatchSend(ctx, &rpc.BatchSendRequest{Requests: []*rpc.SendRequest{
+		{Message: &rpc.Message{Chat: "jane:john", Text: "1", Sender: "jane"}},
+		{Message: &rpc.Message{Chat: "jane:john", Text: "2", Sender: "jane"}},
+		{Message: &rpc.Message{Chat: "jane:john", Text: "3", Sender: "jane"}},
+	}})
+	assert.NoError(t, err)
+	assert.Equal(t, []int32{0, 0, 429}, []int32{batch.Responses[0].Code, batch.Responses[1].Code, batch.Responses[2].Code})
+
+	user := "john"
+	pull, err := s.Pull(ctx, &rpc.PullRequest{Chat: "doe:john", User: &user})
+	assert.NoError(t, err)
+	assert.Equal(t, int32(0), pull.Code)
+	assert.Equal(t, 3, len(pull.Messages))
+	pull, err = s.Pull(ctx, &rpc.PullRequest{Chat: "jane:john", User: &user})
+	assert.NoError(t, err)
+	assert.Equal(t, int32(429), pull.Code)
+	assert.InDelta(t, 60000, pull.GetRetryAfterMs(), 1000)
+
+	multi, err := s.MultiPull(ctx, &rpc.MultiPullRequest{User: "doe", Chats: []*rpc.ChatCursor{{Chat: "doe:john"}, {Chat: "john:doe"}}})
+	assert.NoError(t, err)
+	assert.Equal(t, int32(0), multi.Code)
+	assert.Equal(t, int32(0), multi.Responses[0].Code)
+	assert.Equal(t, int32(429), multi.Responses[1].Code)
+	multi, err = s.MultiPull(ctx, &rpc.MultiPullRequest{User: "doe", Chats: []*rpc.ChatCursor{{Chat: "doe:john"}}})
+	assert.NoError(t, err)
+	assert.Equal(t, int32(429), multi.Code)
+	assert.InDelta(t, 60000, multi.GetRetryAfterMs(), 1000)
+
+	// Retries of delivered messages are answered without taking tokens, and
+	// rejected messages may be retried with the same key.
+	retry := func(key string) *rpc.SendResponse {
+		resp, err := s.Send(ctx, &rpc.SendRequest{
+			Message:        &rpc.Message{Chat: "ann:bob", Text: key, Sender: "ann"},
+			IdempotencyKey: &key,
+		})
+		assert.NoError(t, err)
+		return resp
+	}
+	first := retry("1")
+	assert.Equal(t, int32(0), first.Code)
+	for i := 0; i < 3; i++ {
+		resp = retry("1")
+		assert.Equal(t, int32(0), resp.Code)
+		assert.Equal(t, first.GetID(), resp.GetID())
+	}
+	assert.Equal(t, int32(0), retry("2").Code)
+	assert.Equal(t, int32(429), retry("3").Code)
+	s.limits = nil
+	resp = retry("3")
+	assert.Equal(t, int32(0), resp.Code)
+	assert.Equal(t, int64(3), resp.GetID())
+}
@@ -0,0 +1,46 @@
+package main
+
+import (
+	"context"
+
+	"github.com/TikTokTechImmersion/assignment_demo_2023/rpc-server/kitex_gen/rpc"
+)
+
+// RetentionStore keeps the retention policies of chats.
+type RetentionStore interface {
+	// Retention returns the retention policy of chat, all zero if none was
+	// set.
+	Retention(ctx context.Context, chat string) (*rpc.Retention, error)
+	// SetRetention sets the retention policy of chat.
+	SetRetention(ctx context.Context, chat string, policy *rpc.Retention) error
+	// Chats returns the chats whose retention policy was ever set, in no
+	// particular order. Chats whose policy was reset since are listed too,
+	// as they may still hold disappearing messages.
+	Chats(ctx context.Context) ([]string, error)
+	// Close releases the resources held by the store.
+	Close() error
+}
+
+// purgeQuery returns the query of the messages of chat expired at now by its
+// retention policy, reading the latest ID of the chat from store if the
+// policy bounds the number of messages.
+func purgeQuery(ctx context.Context, store MessageStore, retention RetentionStore, chat string, now int64) (PurgeQuery, error) {
+	q := PurgeQuery{Chat: chat, Now: now}
+	policy, err := retention.Retention(ctx, chat)
+	if err != nil {
+		return q, err
+	}
+	if policy.MaxAge > 0 {
+		q.SentBefore = now - policy.MaxAge*1e6
+	}
+	if policy.MaxCount > 0 {
+		last, err := store.Range(ctx, chat, 0, 1, true)
+		if err != nil {
+			return q, err
+		}
+		if len(last) > 0 {
+			q.MaxID = last[0].ID - policy.MaxCount
+		}
+	}
+	return q, nil
+}
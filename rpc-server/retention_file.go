@@ -0,0 +1,80 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"sync"
+
+	"github.com/TikTokTechImmersion/assignment_demo_2023/rpc-server/kitex_gen/rpc"
+)
+
+// retentionRecord is a record of the retention journal.
+type retentionRecord struct {
+	Chat      string
+	Retention *rpc.Retention
+}
+
+// fileRetentionStore is a RetentionStore backed by an append-only journal of
+// policy changes on local disk, replayed into memory when opened.
+type fileRetentionStore struct {
+	mu       sync.RWMutex
+	journal  *journal
+	policies map[string]*rpc.Retention
+}
+
+// OpenFileRetentionStore opens the retention journal at path, creating it if
+// needed.
+func OpenFileRetentionStore(path string) (RetentionStore, error) {
+	s := &fileRetentionStore{policies: make(map[string]*rpc.Retention)}
+	j, err := openJournal(path, func(line []byte) error {
+		var rec retentionRecord
+		if err := json.Unmarshal(line, &rec); err != nil {
+			return err
+		}
+		s.policies[rec.Chat] = rec.Retention
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	s.journal = j
+	return s, nil
+}
+
+func (s *fileRetentionStore) Retention(ctx context.Context, chat string) (*rpc.Retention, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	policy, ok := s.policies[chat]
+	if !ok || policy == nil {
+		return rpc.NewRetention(), nil
+	}
+	cp := *policy
+	return &cp, nil
+}
+
+func (s *fileRetentionStore) SetRetention(ctx context.Context, chat string, policy *rpc.Retention) error {
+	cp := *policy
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if err := s.journal.append(&retentionRecord{Chat: chat, Retention: &cp}); err != nil {
+		return err
+	}
+	s.policies[chat] = &cp
+	return nil
+}
+
+func (s *fileRetentionStore) Chats(ctx context.Context) ([]string, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	chats := make([]string, 0, len(s.policies))
+	for chat := range s.policies {
+		chats = append(chats, chat)
+	}
+	return chats, nil
+}
+
+func (s *fileRetentionStore) Close() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.journal.Close()
+}
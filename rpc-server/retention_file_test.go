@@ -0,0 +1,38 @@
+package main
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+
+	"github.com/TikTokTechImmersion/assignment_demo_2023/rpc-server/kitex_gen/rpc"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFileRetentionStore(t *testing.T) {
+	ctx := context.Background()
+	path := filepath.Join(t.TempDir(), "retention.log")
+	store, err := OpenFileRetentionStore(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.NoError(t, store.SetRetention(ctx, "a:b", &rpc.Retention{MaxAge: 60, MaxCount: 10}))
+	assert.NoError(t, store.SetRetention(ctx, "a:b", &rpc.Retention{DisappearAfter: 5}))
+	assert.NoError(t, store.SetRetention(ctx, "group-1", &rpc.Retention{}))
+	assert.NoError(t, store.Close())
+
+	store, err = OpenFileRetentionStore(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer store.Close()
+	policy, err := store.Retention(ctx, "a:b")
+	assert.NoError(t, err)
+	assert.Equal(t, &rpc.Retention{DisappearAfter: 5}, policy)
+	policy, err = store.Retention(ctx, "a:c")
+	assert.NoError(t, err)
+	assert.Equal(t, &rpc.Retention{}, policy)
+	chats, err := store.Chats(ctx)
+	assert.NoError(t, err)
+	assert.ElementsMatch(t, []string{"a:b", "group-1"}, chats)
+}
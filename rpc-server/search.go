@@ -0,0 +1,102 @@
+package main
+
+import (
+	"context"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/TikTokTechImmersion/assignment_demo_2023/rpc-server/kitex_gen/rpc"
+	"golang.org/x/text/unicode/norm"
+)
+
+// SearchIndex is an inverted index of the text of the messages of every
+// chat, kept up to date as messages are sent, edited and deleted.
+type SearchIndex interface {
+	// Index adds msgs to the index, replacing the messages with the same
+	// chat and ID. Deleted messages are removed from the index.
+	Index(ctx context.Context, msgs ...*rpc.Message) error
+	// Search returns up to limit messages of chats matching every token of
+	// query, starting at cursor inclusively. Matches are sorted by their
+	// position in descending order, and a zero cursor starts from the latest
+	// match. Positions are the send times of the messages, moved forward on
+	// collisions so that they are unique within the index.
+	Search(ctx context.Context, chats []string, query string, cursor int64, limit int) ([]SearchHit, error)
+	// Close releases the resources held by the index.
+	Close() error
+}
+
+// SearchHit is a message matched by SearchIndex.Search.
+type SearchHit struct {
+	Chat     string
+	ID       int64
+	Position int64
+}
+
+// maxTokenLen bounds the length of the indexed tokens, longer words are more
+// likely to be data than text worth searching.
+const maxTokenLen = 64
+
+// tokenize splits text into the distinct tokens to index or search, in
+// order. Text is normalised to NFKC and lower cased first, so that full and
+// half width forms or letter cases match each other. Words are the runs of
+// letters and digits, except in scripts written without spaces, such as
+// Chinese, Japanese or Thai, whose runs are split into overlapping bigrams,
+// a run of a single character being its own token.
+func tokenize(text string) []string {
+	text = strings.ToLower(norm.NFKC.String(text))
+	var (
+		tokens []string
+		seen   = make(map[string]bool)
+		word   strings.Builder
+		run    []rune // of a script written without spaces
+	)
+	add := func(token string) {
+		if token != "" && len(token) <= maxTokenLen && !seen[token] {
+			seen[token] = true
+			tokens = append(tokens, token)
+		}
+	}
+	flush := func() {
+		add(word.String())
+		word.Reset()
+		if len(run) == 1 {
+			add(string(run))
+		}
+		for i := 0; i+1 < len(run); i++ {
+			add(string(run[i : i+2]))
+		}
+		run = run[:0]
+	}
+	for _, r := range text {
+		switch {
+		case unspaced(r):
+			if word.Len() > 0 {
+				flush()
+			}
+			run = append(run, r)
+		case unicode.IsLetter(r) || unicode.IsDigit(r) || unicode.Is(unicode.Mn, r):
+			if len(run) > 0 {
+				flush()
+			}
+			word.WriteRune(r)
+		default:
+			flush()
+		}
+	}
+	flush()
+	return tokens
+}
+
+// unspacedScripts are the scripts whose words are not reliably separated by
+// spaces.
+var unspacedScripts = []*unicode.RangeTable{
+	unicode.Han, unicode.Hiragana, unicode.Katakana, unicode.Hangul,
+	unicode.Thai, unicode.Lao, unicode.Khmer, unicode.Myanmar,
+}
+
+func unspaced(r rune) bool {
+	// The prolonged sound mark is shared by Hiragana and Katakana, so it
+	// belongs to neither script.
+	return r >= utf8.RuneSelf && (unicode.IsOneOf(unspacedScripts, r) || r == 'ー')
+}
@@ -0,0 +1,242 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"sort"
+	"sync"
+
+	"github.com/TikTokTechImmersion/assignment_demo_2023/rpc-server/kitex_gen/rpc"
+)
+
+// searchRecord is a record of the search journal, the latest version of a
+// message to index or, if Deleted, its removal from the index. Records written by rewrites hold the tokens of the
+// message rather than its text, and its position as SendTime.
+type searchRecord struct {
+	Chat     string
+	ID       int64
+	SendTime int64
+	Text     string   `json:",omitempty"`
+	Tokens   []string `json:",omitempty"`
+	Deleted  bool     `json:",omitempty"`
+}
+
+// fileSearchIndex is a SearchIndex held in memory, rebuilt on startup from
+// an append-only journal of the indexed messages on local disk. Like the
+// inboxes, it only indexes the messages sent through this rpc-server.
+// Removals are appended as records of their own, and the journal is rewritten
+// once most of its records are stale, erasing the text of removed messages.
+type fileSearchIndex struct {
+	mu        sync.RWMutex
+	journal   *journal
+	postings  map[string]map[string][]int64 // positions of the messages by chat and token, sorted
+	docs      map[int64]*searchDoc
+	positions map[searchKey]int64
+	records   int // in the journal
+}
+
+// searchDoc is an indexed message.
+type searchDoc struct {
+	searchKey
+	tokens []string // sorted
+}
+
+// searchKey identifies a message.
+type searchKey struct {
+	chat string
+	id   int64
+}
+
+// OpenFileSearchIndex opens the search journal at path, creating it if
+// needed.
+func OpenFileSearchIndex(path string) (SearchIndex, error) {
+	x := &fileSearchIndex{
+		postings:  make(map[string]map[string][]int64),
+		docs:      make(map[int64]*searchDoc),
+		positions: make(map[searchKey]int64),
+	}
+	j, err := openJournal(path, func(line []byte) error {
+		var rec searchRecord
+		if err := json.Unmarshal(line, &rec); err != nil {
+			return err
+		}
+		x.apply(rec)
+		x.records++
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	x.journal = j
+	return x, nil
+}
+
+// apply indexes rec. Messages keep their position when indexed again, so
+// that edits do not move them between pages of results.
+func (x *fileSearchIndex) apply(rec searchRecord) {
+	key := searchKey{chat: rec.Chat, id: rec.ID}
+	pos, ok := x.positions[key]
+	if ok {
+		postings := x.postings[rec.Chat]
+		for _, token := range x.docs[pos].tokens {
+			postings[token] = removeInt64(postings[token], pos)
+			if len(postings[token]) == 0 {
+				delete(postings, token)
+			}
+		}
+		if len(postings) == 0 {
+			delete(x.postings, rec.Chat)
+		}
+		if rec.Deleted {
+			delete(x.docs, pos)
+			delete(x.positions, key)
+			return
+		}
+	} else {
+		if rec.Deleted {
+			return
+		}
+		pos = rec.SendTime
+		for x.docs[pos] != nil {
+			pos++
+		}
+		x.positions[key] = pos
+	}
+	tokens := rec.Tokens
+	if tokens == nil {
+		tokens = tokenize(rec.Text)
+		sort.Strings(tokens)
+	}
+	x.docs[pos] = &searchDoc{searchKey: key, tokens: tokens}
+	postings := x.postings[rec.Chat]
+	if postings == nil && len(tokens) > 0 {
+		postings = make(map[string][]int64)
+		x.postings[rec.Chat] = postings
+	}
+	for _, token := range tokens {
+		postings[token] = insertInt64(postings[token], pos)
+	}
+}
+
+func (x *fileSearchIndex) Index(ctx context.Context, msgs ...*rpc.Message) error {
+	recs := make([]searchRecord, 0, len(msgs))
+	records := make([]interface{}, 0, len(msgs))
+	for _, msg := range msgs {
+		rec := searchRecord{Chat: msg.Chat, ID: msg.ID, SendTime: msg.SendTime, Text: msg.Text}
+		if msg.Deleted {
+			rec = searchRecord{Chat: msg.Chat, ID: msg.ID, Deleted: true}
+		}
+		recs = append(recs, rec)
+		records = append(records, rec)
+	}
+	x.mu.Lock()
+	defer x.mu.Unlock()
+	if err := x.journal.append(records...); err != nil {
+		return err
+	}
+	x.records += len(records)
+	for _, rec := range recs {
+		x.apply(rec)
+	}
+	return x.compact()
+}
+
+// compact rewrites the journal with a record per indexed message, dropping
+// the records of the removed ones, once most of its records are stale.
+func (x *fileSearchIndex) compact() error {
+	if stale := x.records - len(x.docs); stale < fileRewriteMin || stale < len(x.docs) {
+		return nil
+	}
+	records := make([]interface{}, 0, len(x.docs))
+	for pos, doc := range x.docs {
+		records = append(records, searchRecord{Chat: doc.chat, ID: doc.id, SendTime: pos, Tokens: doc.tokens})
+	}
+	if err := x.journal.rewrite(records...); err != nil {
+		return err
+	}
+	x.records = len(records)
+	return nil
+}
+
+func (x *fileSearchIndex) Search(ctx context.Context, chats []string, query string, cursor int64, limit int) ([]SearchHit, error) {
+	x.mu.RLock()
+	defer x.mu.RUnlock()
+	tokens := tokenize(query)
+	if len(tokens) == 0 {
+		return nil, nil
+	}
+	var hits []SearchHit
+	for _, chat := range chats {
+		hits = append(hits, x.searchChat(chat, tokens, cursor, limit)...)
+	}
+	// Merge the pages of the chats, latest first.
+	sort.Slice(hits, func(i, j int) bool { return hits[i].Position > hits[j].Position })
+	if limit > 0 && len(hits) > limit {
+		hits = hits[:limit]
+	}
+	return hits, nil
+}
+
+// searchChat returns up to limit messages of chat holding every token,
+// before the cursor and latest first. It walks the shortest posting list of
+// the chat, checking the other tokens on the way.
+func (x *fileSearchIndex) searchChat(chat string, tokens []string, cursor int64, limit int) []SearchHit {
+	postings := x.postings[chat]
+	var shortest []int64
+	for i, token := range tokens {
+		list, ok := postings[token]
+		if !ok {
+			return nil
+		}
+		if i == 0 || len(list) < len(shortest) {
+			shortest = list
+		}
+	}
+	i := len(shortest) - 1
+	if cursor > 0 {
+		i = sort.Search(len(shortest), func(i int) bool { return shortest[i] > cursor }) - 1
+	}
+	var hits []SearchHit
+	for ; i >= 0 && (limit <= 0 || len(hits) < limit); i-- {
+		doc := x.docs[shortest[i]]
+		if hasTokens(doc.tokens, tokens) {
+			hits = append(hits, SearchHit{Chat: doc.chat, ID: doc.id, Position: shortest[i]})
+		}
+	}
+	return hits
+}
+
+func (x *fileSearchIndex) Close() error {
+	x.mu.Lock()
+	defer x.mu.Unlock()
+	return x.journal.Close()
+}
+
+// hasTokens reports whether the sorted tokens hold every token of want.
+func hasTokens(tokens, want []string) bool {
+	for _, token := range want {
+		i := sort.SearchStrings(tokens, token)
+		if i == len(tokens) || tokens[i] != token {
+			return false
+		}
+	}
+	return true
+}
+
+// insertInt64 inserts v into the sorted list.
+func insertInt64(list []int64, v int64) []int64 {
+	i := sort.Search(len(list), func(i int) bool { return list[i] >= v })
+	list = append(list, 0)
+	copy(list[i+1:], list[i:])
+	list[i] = v
+	return list
+}
+
+// removeInt64 removes v from the sorted list, if there.
+func removeInt64(list []int64, v int64) []int64 {
+	i := sort.Search(len(list), func(i int) bool { return list[i] >= v })
+	if i < len(list) && list[i] == v {
+		list = append(list[:i], list[i+1:]...)
+	}
+	return list
+}
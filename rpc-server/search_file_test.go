@@ -0,0 +1,139 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/TikTokTechImmersion/assignment_demo_2023/rpc-server/kitex_gen/rpc"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFileSearchIndex(t *testing.T) {
+	ctx := context.Background()
+	path := filepath.Join(t.TempDir(), "search.log")
+	index, err := OpenFileSearchIndex(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.NoError(t, index.Index(ctx,
+		&rpc.Message{Chat: "a:b", ID: 1, SendTime: 10, Text: "lunch at noon?"},
+		&rpc.Message{Chat: "a:c", ID: 1, SendTime: 10, Text: "Lunch tomorrow"},
+		&rpc.Message{Chat: "a:b", ID: 2, SendTime: 20, Text: "明天去北京吃午饭"},
+		&rpc.Message{Chat: "a:b", ID: 3, SendTime: 30, Text: "no LUNCH today"},
+	))
+	assert.NoError(t, index.Index(ctx, &rpc.Message{Chat: "a:b", ID: 3, SendTime: 30, Text: "dinner today"}))
+	assert.NoError(t, index.Index(ctx, &rpc.Message{Chat: "a:c", ID: 2, SendTime: 40, Text: "lunch", Deleted: true}))
+
+	tests := []struct {
+		name   string
+		chats  []string
+		query  string
+		cursor int64
+		limit  int
+		want   []SearchHit
+	}{
+		{name: "one chat", chats: []string{"a:b"}, query: "Lunch", want: []SearchHit{{Chat: "a:b", ID: 1, Position: 10}}},
+		{name: "every chat", chats: []string{"a:b", "a:c"}, query: "lunch", want: []SearchHit{
+			// Send times collide, the message indexed last moves forward.
+			{Chat: "a:c", ID: 1, Position: 11},
+			{Chat: "a:b", ID: 1, Position: 10},
+		}},
+		{name: "cursor", chats: []string{"a:b", "a:c"}, query: "lunch", cursor: 10, want: []SearchHit{{Chat: "a:b", ID: 1, Position: 10}}},
+		{name: "limit", chats: []string{"a:b", "a:c"}, query: "lunch", limit: 1, want: []SearchHit{{Chat: "a:c", ID: 1, Position: 11}}},
+		{name: "every word", chats: []string{"a:b", "a:c"}, query: "lunch noon", want: []SearchHit{{Chat: "a:b", ID: 1, Position: 10}}},
+		{name: "chinese", chats: []string{"a:b"}, query: "北京", want: []SearchHit{{Chat: "a:b", ID: 2, Position: 20}}},
+		{name: "chinese phrase", chats: []string{"a:b"}, query: "去北京", want: []SearchHit{{Chat: "a:b", ID: 2, Position: 20}}},
+		{name: "chinese miss", chats: []string{"a:b"}, query: "南京", want: nil},
+		{name: "edited", chats: []string{"a:b"}, query: "dinner", want: []SearchHit{{Chat: "a:b", ID: 3, Position: 30}}},
+		{name: "out of scope", chats: []string{"b:c"}, query: "lunch", want: nil},
+		{name: "no words", chats: []string{"a:b"}, query: "?!", want: nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := index.Search(ctx, tt.chats, tt.query, tt.cursor, tt.limit)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+
+	// The index is rebuilt from its journal.
+	assert.NoError(t, index.Close())
+	index, err = OpenFileSearchIndex(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer index.Close()
+	got, err := index.Search(ctx, []string{"a:b", "a:c"}, "lunch", 0, 0)
+	assert.NoError(t, err)
+	assert.Equal(t, []SearchHit{{Chat: "a:c", ID: 1, Position: 11}, {Chat: "a:b", ID: 1, Position: 10}}, got)
+}
+
+func TestFileSearchIndex_Remove(t *testing.T) {
+	ctx := context.Background()
+	path := filepath.Join(t.TempDir(), "search.log")
+	index, err := OpenFileSearchIndex(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.NoError(t, index.Index(ctx,
+		&rpc.Message{Chat: "a:b", ID: 1, SendTime: 10, Text: "lunch at noon"},
+		&rpc.Message{Chat: "a:b", ID: 2, SendTime: 10, Text: "secret lunch plans"},
+		&rpc.Message{Chat: "a:b", ID: 3, SendTime: 30},
+	))
+	assert.NoError(t, index.Index(ctx, &rpc.Message{Chat: "a:b", ID: 2, SendTime: 10, Deleted: true}))
+
+	// Removals are appended, the journal is only rewritten once most of its
+	// records are stale.
+	data, err := os.ReadFile(path)
+	assert.NoError(t, err)
+	assert.Equal(t, 4, bytes.Count(data, []byte("\n")))
+
+	assert.NoError(t, index.Close())
+	index, err = OpenFileSearchIndex(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer index.Close()
+	got, err := index.Search(ctx, []string{"a:b"}, "lunch", 0, 0)
+	assert.NoError(t, err)
+	assert.Equal(t, []SearchHit{{Chat: "a:b", ID: 1, Position: 10}}, got)
+	got, err = index.Search(ctx, []string{"a:b"}, "secret", 0, 0)
+	assert.NoError(t, err)
+	assert.Empty(t, got)
+
+	// Positions survive rewrites, so that new messages do not take them.
+	assert.NoError(t, index.Index(ctx, &rpc.Message{Chat: "a:b", ID: 4, SendTime: 10, Text: "lunch"}))
+	got, err = index.Search(ctx, []string{"a:b"}, "lunch", 0, 0)
+	assert.NoError(t, err)
+	assert.Equal(t, []SearchHit{{Chat: "a:b", ID: 4, Position: 11}, {Chat: "a:b", ID: 1, Position: 10}}, got)
+}
+
+func TestFileSearchIndex_Compact(t *testing.T) {
+	ctx := context.Background()
+	path := filepath.Join(t.TempDir(), "search.log")
+	index, err := OpenFileSearchIndex(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer index.Close()
+	var msgs, removed []*rpc.Message
+	for id := int64(1); id <= fileRewriteMin; id++ {
+		msgs = append(msgs, &rpc.Message{Chat: "a:b", ID: id, SendTime: id, Text: "secret lunch plans"})
+		removed = append(removed, &rpc.Message{Chat: "a:b", ID: id, SendTime: id, Deleted: true})
+	}
+	msgs[0].Text = "lunch at noon"
+	assert.NoError(t, index.Index(ctx, msgs...))
+	assert.NoError(t, index.Index(ctx, removed[1:]...))
+
+	// The text of removed messages is erased from the journal.
+	data, err := os.ReadFile(path)
+	assert.NoError(t, err)
+	assert.NotContains(t, string(data), "secret")
+	assert.Equal(t, 1, bytes.Count(data, []byte("\n")))
+	got, err := index.Search(ctx, []string{"a:b"}, "lunch", 0, 0)
+	assert.NoError(t, err)
+	assert.Equal(t, []SearchHit{{Chat: "a:b", ID: 1, Position: 1}}, got)
+}
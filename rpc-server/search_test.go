@@ -0,0 +1,32 @@
+package main
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTokenize(t *testing.T) {
+	tests := []struct {
+		name string
+		text string
+		want []string
+	}{
+		{name: "words", text: "Hello, World! hello again", want: []string{"hello", "world", "again"}},
+		{name: "digits", text: "room 42b at 9:30", want: []string{"room", "42b", "at", "9", "30"}},
+		{name: "accents", text: "Crème brûlée", want: []string{"crème", "brûlée"}},
+		{name: "cyrillic", text: "Привет, мир", want: []string{"привет", "мир"}},
+		{name: "full width", text: "ＡＢＣ　１２３", want: []string{"abc", "123"}},
+		{name: "chinese", text: "北京大学", want: []string{"北京", "京大", "大学"}},
+		{name: "single han", text: "京", want: []string{"京"}},
+		{name: "mixed scripts", text: "去Tokyo玩", want: []string{"去", "tokyo", "玩"}},
+		{name: "japanese", text: "東京タワー", want: []string{"東京", "京タ", "タワ", "ワー"}},
+		{name: "emoji", text: "🎉 party", want: []string{"party"}},
+		{name: "empty", text: " ... ", want: nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, tokenize(tt.text))
+		})
+	}
+}
@@ -0,0 +1,49 @@
+package main
+
+import (
+	"context"
+	"errors"
+)
+
+var (
+	errSessionNotFound = errors.New("session not found")
+	errRefreshReused   = errors.New("refresh token was already used")
+)
+
+// session is a login of a user, kept alive by exchanging its refresh token
+// for a new one before it expires.
+type session struct {
+	ID         string
+	User       string
+	Refresh    []byte // SHA-256 of the secret of the latest refresh token
+	ExpireTime int64  // unit: microseconds, when the latest refresh token expires
+	Revoked    bool
+	RevokeTime int64 // unit: microseconds, when the session was revoked
+}
+
+// SessionStore keeps the sessions of users. Revoked sessions are kept as
+// well, they form the revocation list checked on every refresh, and by the
+// frontends on every request while their access tokens are valid.
+type SessionStore interface {
+	// CreateSession stores the new session s.
+	CreateSession(ctx context.Context, s *session) error
+	// Session returns the session with ID id.
+	// It returns errSessionNotFound if there is none.
+	Session(ctx context.Context, id string) (*session, error)
+	// RotateSession replaces the refresh token of the session with ID id,
+	// whose hash must be prev, with the one hashed as next, expiring at
+	// expireTime. It returns errRefreshReused if the hash of the refresh
+	// token of the session is not prev, and errSessionNotFound if there is
+	// no such session.
+	RotateSession(ctx context.Context, id string, prev, next []byte, expireTime int64) error
+	// RevokeSession revokes the session with ID id at revokeTime, unit:
+	// microseconds, after which it cannot be refreshed anymore. Sessions
+	// revoked already keep their revocation time. It returns
+	// errSessionNotFound if there is no such session.
+	RevokeSession(ctx context.Context, id string, revokeTime int64) error
+	// RevokedSessions returns the IDs of the sessions revoked from since on,
+	// unit: microseconds, sorted by revocation time.
+	RevokedSessions(ctx context.Context, since int64) ([]string, error)
+	// Close releases the resources held by the store.
+	Close() error
+}
@@ -0,0 +1,138 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"sync"
+)
+
+// sessionOp is a record of the session journal.
+type sessionOp struct {
+	Op      string // one of "create", "rotate" or "revoke"
+	Session session
+}
+
+// fileSessionStore is a SessionStore backed by an append-only journal of
+// session changes on local disk, replayed into memory when opened.
+type fileSessionStore struct {
+	mu       sync.RWMutex
+	journal  *journal
+	sessions map[string]*session
+	revoked  []*session // sorted by revocation time
+}
+
+// OpenFileSessionStore opens the session journal at path, creating it if
+// needed.
+func OpenFileSessionStore(path string) (SessionStore, error) {
+	s := &fileSessionStore{sessions: make(map[string]*session)}
+	j, err := openJournal(path, func(line []byte) error {
+		var op sessionOp
+		if err := json.Unmarshal(line, &op); err != nil {
+			return err
+		}
+		return s.apply(op)
+	})
+	if err != nil {
+		return nil, err
+	}
+	s.journal = j
+	return s, nil
+}
+
+func (s *fileSessionStore) apply(op sessionOp) error {
+	cur, ok := s.sessions[op.Session.ID]
+	switch {
+	case op.Op == "create" && ok:
+		return fmt.Errorf("session %q already exists", op.Session.ID)
+	case op.Op != "create" && !ok:
+		return errSessionNotFound
+	}
+	switch op.Op {
+	case "create":
+		sess := op.Session
+		s.sessions[sess.ID] = &sess
+	case "rotate":
+		cur.Refresh, cur.ExpireTime = op.Session.Refresh, op.Session.ExpireTime
+	case "revoke":
+		if cur.Revoked {
+			break
+		}
+		cur.Revoked, cur.RevokeTime = true, op.Session.RevokeTime
+		i := sort.Search(len(s.revoked), func(i int) bool { return s.revoked[i].RevokeTime > cur.RevokeTime })
+		s.revoked = append(s.revoked, nil)
+		copy(s.revoked[i+1:], s.revoked[i:])
+		s.revoked[i] = cur
+	default:
+		return fmt.Errorf("unknown operation %q", op.Op)
+	}
+	return nil
+}
+
+// write records op in the journal, then applies it.
+func (s *fileSessionStore) write(op sessionOp) error {
+	if err := s.journal.append(op); err != nil {
+		return err
+	}
+	return s.apply(op)
+}
+
+func (s *fileSessionStore) CreateSession(ctx context.Context, sess *session) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if _, ok := s.sessions[sess.ID]; ok {
+		return fmt.Errorf("session %q already exists", sess.ID)
+	}
+	return s.write(sessionOp{Op: "create", Session: *sess})
+}
+
+func (s *fileSessionStore) Session(ctx context.Context, id string) (*session, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	sess, ok := s.sessions[id]
+	if !ok {
+		return nil, errSessionNotFound
+	}
+	cp := *sess
+	return &cp, nil
+}
+
+func (s *fileSessionStore) RotateSession(ctx context.Context, id string, prev, next []byte, expireTime int64) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	sess, ok := s.sessions[id]
+	if !ok {
+		return errSessionNotFound
+	} else if !bytes.Equal(sess.Refresh, prev) {
+		return errRefreshReused
+	}
+	return s.write(sessionOp{Op: "rotate", Session: session{ID: id, Refresh: next, ExpireTime: expireTime}})
+}
+
+func (s *fileSessionStore) RevokeSession(ctx context.Context, id string, revokeTime int64) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if _, ok := s.sessions[id]; !ok {
+		return errSessionNotFound
+	}
+	return s.write(sessionOp{Op: "revoke", Session: session{ID: id, RevokeTime: revokeTime}})
+}
+
+func (s *fileSessionStore) RevokedSessions(ctx context.Context, since int64) ([]string, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	i := sort.Search(len(s.revoked), func(i int) bool { return s.revoked[i].RevokeTime >= since })
+	ids := make([]string, 0, len(s.revoked)-i)
+	for _, sess := range s.revoked[i:] {
+		ids = append(ids, sess.ID)
+	}
+	return ids, nil
+}
+
+func (s *fileSessionStore) Close() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.journal.Close()
+}
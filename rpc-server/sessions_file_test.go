@@ -0,0 +1,51 @@
+package main
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFileSessionStore(t *testing.T) {
+	ctx := context.Background()
+	path := filepath.Join(t.TempDir(), "sessions.log")
+	store, err := OpenFileSessionStore(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.NoError(t, store.CreateSession(ctx, &session{ID: "s1", User: "john", Refresh: []byte("r1"), ExpireTime: 10}))
+	assert.NoError(t, store.CreateSession(ctx, &session{ID: "s2", User: "doe", Refresh: []byte("r1"), ExpireTime: 10}))
+	assert.Error(t, store.CreateSession(ctx, &session{ID: "s1", User: "doe"}))
+	assert.NoError(t, store.RotateSession(ctx, "s1", []byte("r1"), []byte("r2"), 20))
+	assert.ErrorIs(t, store.RotateSession(ctx, "s1", []byte("r1"), []byte("r3"), 30), errRefreshReused)
+	assert.ErrorIs(t, store.RotateSession(ctx, "s3", []byte("r1"), []byte("r2"), 20), errSessionNotFound)
+	assert.NoError(t, store.RevokeSession(ctx, "s2", 30))
+	assert.NoError(t, store.RevokeSession(ctx, "s1", 20))
+	assert.NoError(t, store.RevokeSession(ctx, "s2", 40))
+	assert.ErrorIs(t, store.RevokeSession(ctx, "s3", 40), errSessionNotFound)
+	assert.NoError(t, store.Close())
+
+	store, err = OpenFileSessionStore(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer store.Close()
+	sess, err := store.Session(ctx, "s1")
+	assert.NoError(t, err)
+	assert.Equal(t, &session{ID: "s1", User: "john", Refresh: []byte("r2"), ExpireTime: 20, Revoked: true, RevokeTime: 20}, sess)
+	sess, err = store.Session(ctx, "s2")
+	assert.NoError(t, err)
+	assert.True(t, sess.Revoked)
+	// Sessions keep the time they were first revoked.
+	assert.Equal(t, int64(30), sess.RevokeTime)
+	ids, err := store.RevokedSessions(ctx, 0)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"s1", "s2"}, ids)
+	ids, err = store.RevokedSessions(ctx, 21)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"s2"}, ids)
+	_, err = store.Session(ctx, "s3")
+	assert.ErrorIs(t, err, errSessionNotFound)
+}
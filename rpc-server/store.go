@@ -0,0 +1,175 @@
+package main
+
+import (
+	"context"
+	"errors"
+
+	"github.com/TikTokTechImmersion/assignment_demo_2023/rpc-server/kitex_gen/rpc"
+)
+
+var (
+	errMessageNotFound = errors.New("message not found")
+	errMessageDeleted  = errors.New("message is deleted")
+)
+
+// MessageStore persists the messages of every chat, ordered by send time.
+type MessageStore interface {
+	// Append stores msgs at the end of their chats, in order. It assigns each
+	// message the next ID of its chat and, if needed, moves its SendTime past
+	// the last send or update time of the chat, so that both strictly
+	// increase within a chat. UpdateTime is set to SendTime. The messages of
+	// a chat are stored atomically, but when Append fails the messages of
+	// other chats may have been stored.
+	Append(ctx context.Context, msgs ...*rpc.Message) error
+	// Update applies change to the message of chat with ID id and stores it
+	// at updateTime, moved past the last send or update time of the chat if
+	// needed, then returns it. change is called with the stored version, as
+	// part of the same atomic update, so that the checks it makes still hold
+	// when the message is stored. It may be called more than once. If change
+	// returns an error, the message is left as is and Update returns it.
+	// The replaced version is kept in the history of the message if its text
+	// changed, and the history and reactions are dropped if the message is
+	// deleted. It returns errMessageNotFound if there is no such message, and
+	// errMessageDeleted if it is deleted already.
+	Update(ctx context.Context, chat string, id, updateTime int64, change func(msg *rpc.Message) error) (*rpc.Message, error)
+	// React adds the reaction of user with emoji to the message of chat with
+	// ID id, or removes it, and returns the message. Unless the reaction was
+	// already added or removed, the message is updated at updateTime as
+	// Update would. It returns errMessageNotFound if there is no such message,
+	// and errMessageDeleted if it is deleted.
+	React(ctx context.Context, chat string, id int64, emoji, user string, add bool, updateTime int64) (*rpc.Message, error)
+	// Range returns up to limit messages of chat starting at cursor, inclusively.
+	// Messages are sorted by send time, in descending order if reverse is set,
+	// in which case a zero cursor starts from the latest message.
+	// A non-positive limit returns every remaining message.
+	Range(ctx context.Context, chat string, cursor int64, limit int, reverse bool) ([]*rpc.Message, error)
+	// MultiRange runs every query as Range would, in as few round trips as
+	// the store allows, and returns their results in order.
+	MultiRange(ctx context.Context, queries []RangeQuery) ([][]*rpc.Message, error)
+	// Thread returns up to limit replies of the thread of chat started by the
+	// message with ID root, those with RootID root, starting at cursor
+	// inclusively. Replies are sorted by send time in ascending order.
+	// A non-positive limit returns every remaining reply.
+	Thread(ctx context.Context, chat string, root, cursor int64, limit int) ([]*rpc.Message, error)
+	// Get returns the message of chat with the given ID.
+	// It returns errMessageNotFound if there is no such message.
+	Get(ctx context.Context, chat string, id int64) (*rpc.Message, error)
+	// Next returns the first message of chat with an ID greater than id.
+	// It returns errMessageNotFound if there is no such message.
+	Next(ctx context.Context, chat string, id int64) (*rpc.Message, error)
+	// Changes returns up to limit messages of chat sent or updated from
+	// cursor on, inclusively, sorted by update time.
+	// A non-positive limit returns every remaining message.
+	Changes(ctx context.Context, chat string, cursor int64, limit int) ([]*rpc.Message, error)
+	// History returns the previous versions of the message of chat with the
+	// given ID, without their reactions, sorted by update time.
+	History(ctx context.Context, chat string, id int64) ([]*rpc.Message, error)
+	// Purge removes the messages of q.Chat expired by q, along with their
+	// history, and returns them.
+	Purge(ctx context.Context, q PurgeQuery) ([]*rpc.Message, error)
+	// Close releases the resources held by the store.
+	Close() error
+}
+
+// RangeQuery holds the arguments of MessageStore.Range.
+type RangeQuery struct {
+	Chat    string
+	Cursor  int64
+	Limit   int
+	Reverse bool
+}
+
+// PurgeQuery holds the arguments of MessageStore.Purge, the messages of a
+// chat expired at some point in time by its retention policy.
+type PurgeQuery struct {
+	Chat       string
+	SentBefore int64 // messages sent before are expired
+	MaxID      int64 // messages with IDs up to MaxID are expired
+	Now        int64 // disappearing messages expiring up to Now are expired
+}
+
+// expired reports whether q expires msg, a message of q.Chat.
+func (q PurgeQuery) expired(msg *rpc.Message) bool {
+	return msg.SendTime < q.SentBefore || msg.ID <= q.MaxID || msg.ExpireTime != 0 && msg.ExpireTime <= q.Now
+}
+
+// groupByChat splits msgs by chat, keeping their order within each chat.
+// Chats are listed in the order of their first message.
+func groupByChat(msgs []*rpc.Message) (chats []string, byChat map[string][]*rpc.Message) {
+	byChat = make(map[string][]*rpc.Message)
+	for _, msg := range msgs {
+		if _, ok := byChat[msg.Chat]; !ok {
+			chats = append(chats, msg.Chat)
+		}
+		byChat[msg.Chat] = append(byChat[msg.Chat], msg)
+	}
+	return chats, byChat
+}
+
+// stampMessages assigns the IDs and send times of msgs, which follow the
+// message of their chat with lastID and lastTime, as Append specifies.
+func stampMessages(msgs []*rpc.Message, lastID, lastTime int64) {
+	for _, msg := range msgs {
+		lastID++
+		if msg.SendTime <= lastTime {
+			msg.SendTime = lastTime + 1
+		}
+		msg.ID, msg.UpdateTime, lastTime = lastID, msg.SendTime, msg.SendTime
+	}
+}
+
+// updateChange returns the change of the message stores make for an Update
+// with change, reporting whether it changed the message.
+func updateChange(change func(msg *rpc.Message) error) func(msg *rpc.Message) (bool, error) {
+	return func(msg *rpc.Message) (bool, error) {
+		if err := change(msg); err != nil {
+			return false, err
+		}
+		if msg.Deleted {
+			msg.Reactions = nil
+		}
+		return true, nil
+	}
+}
+
+// reactChange returns the change of the message stores make for a React.
+func reactChange(emoji, user string, add bool) func(msg *rpc.Message) (bool, error) {
+	return func(msg *rpc.Message) (bool, error) {
+		return react(msg, emoji, user, add), nil
+	}
+}
+
+// react adds the reaction of user with emoji to msg, or removes it. It
+// reports whether msg changed. The reactions of msg are replaced rather than
+// modified, as they may be shared with other copies of msg.
+func react(msg *rpc.Message, emoji, user string, add bool) bool {
+	reactions := make([]*rpc.Reaction, 0, len(msg.Reactions)+1)
+	changed, found := false, false
+	for _, r := range msg.Reactions {
+		if r.Emoji != emoji {
+			reactions = append(reactions, r)
+			continue
+		}
+		found = true
+		users := removeMembers(r.Users, []string{user})
+		if add {
+			users = addMembers(r.Users, []string{user})
+		}
+		changed = len(users) != len(r.Users)
+		if len(users) > 0 {
+			reactions = append(reactions, &rpc.Reaction{Emoji: emoji, Count: int32(len(users)), Users: users})
+		}
+	}
+	if add && !found {
+		changed = true
+		reactions = append(reactions, &rpc.Reaction{Emoji: emoji, Count: 1, Users: []string{user}})
+	}
+	if !changed {
+		return false
+	}
+	if len(reactions) == 0 {
+		reactions = nil
+	}
+	msg.Reactions = reactions
+	return true
+}
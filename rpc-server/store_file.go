@@ -0,0 +1,464 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"sort"
+	"sync"
+
+	"github.com/TikTokTechImmersion/assignment_demo_2023/rpc-server/kitex_gen/rpc"
+)
+
+// fileStore is a MessageStore backed by an append-only journal on local disk.
+// Every message is written as one JSON record, and written again whenever it
+// is updated. Purges are written as records listing the purged messages. The
+// whole journal is replayed into memory when the store is opened, and
+// rewritten once most of its records are stale.
+type fileStore struct {
+	mu      sync.RWMutex
+	journal *journal
+	chats   map[string]*fileChat
+	records int // in the journal
+}
+
+// fileRecord is a record of the message journal, either a version of a
+// message or a purge.
+type fileRecord struct {
+	rpc.Message
+	Purged   []int64 `json:",omitempty"` // IDs of the purged messages of Chat
+	LastTime int64   `json:",omitempty"` // of the purge
+}
+
+// filePurge is the record of a purge in the message journal. It also
+// records the last ID and time of the chat, which may belong to purged
+// messages, once the journal is rewritten.
+type filePurge struct {
+	Chat     string
+	Purged   []int64
+	LastTime int64
+}
+
+// fileRewriteMin is the minimum number of stale records of the message
+// journal before it is rewritten.
+const fileRewriteMin = 1024
+
+// fileChat holds the messages of a chat.
+type fileChat struct {
+	msgs     []*rpc.Message // sorted by SendTime, and by ID too
+	changes  []fileChange   // sorted by time
+	history  map[int64][]*rpc.Message
+	threads  map[int64][]*rpc.Message // replies by RootID, sorted like msgs
+	lastID   int64                    // including purged messages
+	lastTime int64                    // latest send or update time
+}
+
+// fileChange records that a message was sent or updated at time. Changes are
+// only appended, the change is stale once the message is updated again.
+type fileChange struct {
+	time int64
+	msg  *rpc.Message
+}
+
+// OpenFileStore opens the message log at path, creating it if needed.
+func OpenFileStore(path string) (MessageStore, error) {
+	s := &fileStore{chats: make(map[string]*fileChat)}
+	j, err := openJournal(path, func(line []byte) error {
+		var rec fileRecord
+		if err := json.Unmarshal(line, &rec); err != nil {
+			return err
+		}
+		s.records++
+		if rec.Purged != nil {
+			s.purge(&filePurge{Chat: rec.Chat, Purged: rec.Purged, LastTime: rec.LastTime})
+			return nil
+		}
+		msg := &rec.Message
+		// Messages written before updates existed have no update time.
+		if msg.UpdateTime == 0 {
+			msg.UpdateTime = msg.SendTime
+		}
+		s.apply(msg)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	s.journal = j
+	return s, nil
+}
+
+// chat returns the messages of chat, creating them if needed.
+func (s *fileStore) chat(chat string) *fileChat {
+	c, ok := s.chats[chat]
+	if !ok {
+		c = &fileChat{
+			history: make(map[int64][]*rpc.Message),
+			threads: make(map[int64][]*rpc.Message),
+		}
+		s.chats[chat] = c
+	}
+	return c
+}
+
+func (s *fileStore) Append(ctx context.Context, msgs ...*rpc.Message) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	chats, byChat := groupByChat(msgs)
+	for _, chat := range chats {
+		var lastID, lastTime int64
+		if c, ok := s.chats[chat]; ok {
+			lastID, lastTime = c.lastID, c.lastTime
+		}
+		stampMessages(byChat[chat], lastID, lastTime)
+	}
+	records := make([]interface{}, 0, len(msgs))
+	for _, msg := range msgs {
+		records = append(records, msg)
+	}
+	if err := s.journal.append(records...); err != nil {
+		return err
+	}
+	s.records += len(records)
+	for _, msg := range msgs {
+		s.apply(msg)
+	}
+	return nil
+}
+
+func (s *fileStore) Update(ctx context.Context, chat string, id, updateTime int64, change func(msg *rpc.Message) error) (*rpc.Message, error) {
+	return s.modify(chat, id, updateTime, updateChange(change))
+}
+
+func (s *fileStore) React(ctx context.Context, chat string, id int64, emoji, user string, add bool, updateTime int64) (*rpc.Message, error) {
+	return s.modify(chat, id, updateTime, reactChange(emoji, user, add))
+}
+
+// modify stores the message of chat with ID id as changed by change, called
+// with a copy of the stored version under the lock of the store, at
+// updateTime or right after the last send or update of the chat. It returns
+// the stored version, left as is if change reports no change.
+func (s *fileStore) modify(chat string, id, updateTime int64, change func(msg *rpc.Message) (bool, error)) (*rpc.Message, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	c, ok := s.chats[chat]
+	if !ok {
+		return nil, errMessageNotFound
+	}
+	i := c.find(id)
+	if i < 0 {
+		return nil, errMessageNotFound
+	}
+	msg := *c.msgs[i]
+	if msg.Deleted {
+		return nil, errMessageDeleted
+	}
+	if changed, err := change(&msg); err != nil {
+		return nil, err
+	} else if !changed {
+		cp := *c.msgs[i]
+		return &cp, nil
+	}
+	msg.UpdateTime = updateTime
+	if msg.UpdateTime <= c.lastTime {
+		msg.UpdateTime = c.lastTime + 1
+	}
+	if err := s.journal.append(&msg); err != nil {
+		return nil, err
+	}
+	s.records++
+	s.apply(&msg)
+	return &msg, nil
+}
+
+// apply stores a copy of msg, a new message or a new version of one.
+func (s *fileStore) apply(msg *rpc.Message) {
+	cp := *msg
+	c := s.chat(msg.Chat)
+	if i := c.find(msg.ID); i >= 0 {
+		prev := c.msgs[i]
+		if msg.Deleted {
+			delete(c.history, msg.ID)
+		} else if prev.Text != msg.Text {
+			old := *prev
+			old.Reactions = nil
+			c.history[msg.ID] = append(c.history[msg.ID], &old)
+		}
+		*prev = cp
+		c.changes = append(c.changes, fileChange{time: prev.UpdateTime, msg: prev})
+	} else {
+		c.msgs = insertMessage(c.msgs, &cp)
+		if cp.RootID != 0 {
+			c.threads[cp.RootID] = insertMessage(c.threads[cp.RootID], &cp)
+		}
+		c.changes = append(c.changes, fileChange{time: cp.UpdateTime, msg: &cp})
+	}
+	if msg.ID > c.lastID {
+		c.lastID = msg.ID
+	}
+	if msg.SendTime > c.lastTime {
+		c.lastTime = msg.SendTime
+	}
+	if msg.UpdateTime > c.lastTime {
+		c.lastTime = msg.UpdateTime
+	}
+}
+
+// insertMessage inserts msg into msgs, sorted by send time.
+func insertMessage(msgs []*rpc.Message, msg *rpc.Message) []*rpc.Message {
+	i := sort.Search(len(msgs), func(i int) bool { return msgs[i].SendTime > msg.SendTime })
+	msgs = append(msgs, nil)
+	copy(msgs[i+1:], msgs[i:])
+	msgs[i] = msg
+	return msgs
+}
+
+// find returns the index of the message with the given ID, or -1.
+func (c *fileChat) find(id int64) int {
+	// IDs increase along with send times.
+	i := sort.Search(len(c.msgs), func(i int) bool { return c.msgs[i].ID >= id })
+	if i == len(c.msgs) || c.msgs[i].ID != id {
+		return -1
+	}
+	return i
+}
+
+func (s *fileStore) Range(ctx context.Context, chat string, cursor int64, limit int, reverse bool) ([]*rpc.Message, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.rangeLocked(RangeQuery{Chat: chat, Cursor: cursor, Limit: limit, Reverse: reverse}), nil
+}
+
+func (s *fileStore) MultiRange(ctx context.Context, queries []RangeQuery) ([][]*rpc.Message, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	pages := make([][]*rpc.Message, 0, len(queries))
+	for _, q := range queries {
+		pages = append(pages, s.rangeLocked(q))
+	}
+	return pages, nil
+}
+
+func (s *fileStore) rangeLocked(q RangeQuery) []*rpc.Message {
+	var msgs []*rpc.Message
+	if c, ok := s.chats[q.Chat]; ok {
+		msgs = c.msgs
+	}
+	var page []*rpc.Message
+	if !q.Reverse {
+		i := sort.Search(len(msgs), func(i int) bool { return msgs[i].SendTime >= q.Cursor })
+		for ; i < len(msgs) && (q.Limit <= 0 || len(page) < q.Limit); i++ {
+			page = append(page, msgs[i])
+		}
+	} else {
+		i := len(msgs) - 1
+		if q.Cursor > 0 {
+			i = sort.Search(len(msgs), func(i int) bool { return msgs[i].SendTime > q.Cursor }) - 1
+		}
+		for ; i >= 0 && (q.Limit <= 0 || len(page) < q.Limit); i-- {
+			page = append(page, msgs[i])
+		}
+	}
+	return copyMessages(page)
+}
+
+func (s *fileStore) Thread(ctx context.Context, chat string, root, cursor int64, limit int) ([]*rpc.Message, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	c, ok := s.chats[chat]
+	if !ok {
+		return nil, nil
+	}
+	replies := c.threads[root]
+	var page []*rpc.Message
+	i := sort.Search(len(replies), func(i int) bool { return replies[i].SendTime >= cursor })
+	for ; i < len(replies) && (limit <= 0 || len(page) < limit); i++ {
+		page = append(page, replies[i])
+	}
+	return copyMessages(page), nil
+}
+
+func (s *fileStore) Get(ctx context.Context, chat string, id int64) (*rpc.Message, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	c, ok := s.chats[chat]
+	if !ok {
+		return nil, errMessageNotFound
+	}
+	i := c.find(id)
+	if i < 0 {
+		return nil, errMessageNotFound
+	}
+	cp := *c.msgs[i]
+	return &cp, nil
+}
+
+func (s *fileStore) Next(ctx context.Context, chat string, id int64) (*rpc.Message, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	c, ok := s.chats[chat]
+	if !ok {
+		return nil, errMessageNotFound
+	}
+	i := sort.Search(len(c.msgs), func(i int) bool { return c.msgs[i].ID > id })
+	if i == len(c.msgs) {
+		return nil, errMessageNotFound
+	}
+	cp := *c.msgs[i]
+	return &cp, nil
+}
+
+func (s *fileStore) Changes(ctx context.Context, chat string, cursor int64, limit int) ([]*rpc.Message, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	c, ok := s.chats[chat]
+	if !ok {
+		return nil, nil
+	}
+	var page []*rpc.Message
+	i := sort.Search(len(c.changes), func(i int) bool { return c.changes[i].time >= cursor })
+	for ; i < len(c.changes) && (limit <= 0 || len(page) < limit); i++ {
+		if change := c.changes[i]; change.msg.UpdateTime == change.time {
+			page = append(page, change.msg)
+		}
+	}
+	return copyMessages(page), nil
+}
+
+func (s *fileStore) History(ctx context.Context, chat string, id int64) ([]*rpc.Message, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	c, ok := s.chats[chat]
+	if !ok || c.find(id) < 0 {
+		return nil, errMessageNotFound
+	}
+	return copyMessages(c.history[id]), nil
+}
+
+func (s *fileStore) Purge(ctx context.Context, q PurgeQuery) ([]*rpc.Message, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	c, ok := s.chats[q.Chat]
+	if !ok {
+		return nil, nil
+	}
+	var (
+		ids  []int64
+		msgs []*rpc.Message
+	)
+	for _, msg := range c.msgs {
+		if q.expired(msg) {
+			ids, msgs = append(ids, msg.ID), append(msgs, msg)
+		}
+	}
+	if len(ids) == 0 {
+		return nil, nil
+	}
+	purge := &filePurge{Chat: q.Chat, Purged: ids, LastTime: c.lastTime}
+	if err := s.journal.append(purge); err != nil {
+		return nil, err
+	}
+	s.records++
+	s.purge(purge)
+	if err := s.compact(); err != nil {
+		// The journal is left as it was, it is only larger than needed.
+		return msgs, err
+	}
+	return msgs, nil
+}
+
+// purge applies the record of a purge.
+func (s *fileStore) purge(p *filePurge) {
+	c := s.chat(p.Chat)
+	purged := make(map[int64]bool, len(p.Purged))
+	for _, id := range p.Purged {
+		purged[id] = true
+		delete(c.history, id)
+		if id > c.lastID {
+			c.lastID = id
+		}
+	}
+	if p.LastTime > c.lastTime {
+		c.lastTime = p.LastTime
+	}
+	keep := func(msg *rpc.Message) bool { return !purged[msg.ID] }
+	c.msgs = filterMessages(c.msgs, keep)
+	for root, replies := range c.threads {
+		if replies = filterMessages(replies, keep); len(replies) > 0 {
+			c.threads[root] = replies
+		} else {
+			delete(c.threads, root)
+		}
+	}
+	changes := c.changes[:0]
+	for _, change := range c.changes {
+		if !purged[change.msg.ID] {
+			changes = append(changes, change)
+		}
+	}
+	c.changes = changes
+}
+
+// filterMessages returns the messages of msgs to keep, reusing msgs.
+func filterMessages(msgs []*rpc.Message, keep func(msg *rpc.Message) bool) []*rpc.Message {
+	out := msgs[:0]
+	for _, msg := range msgs {
+		if keep(msg) {
+			out = append(out, msg)
+		}
+	}
+	return out
+}
+
+// compact rewrites the journal with the records of the current messages and
+// their history, once most of its records are stale.
+func (s *fileStore) compact() error {
+	n := 0
+	for _, c := range s.chats {
+		n += len(c.msgs) + 1
+		for _, versions := range c.history {
+			n += len(versions)
+		}
+	}
+	if stale := s.records - n; stale < fileRewriteMin || stale < n {
+		return nil
+	}
+	var live []interface{}
+	for chat, c := range s.chats {
+		for _, msg := range c.msgs {
+			// Replaying the previous versions first rebuilds the history.
+			for _, version := range c.history[msg.ID] {
+				live = append(live, version)
+			}
+			live = append(live, msg)
+		}
+		// Keep the IDs and times of purged messages from being reused.
+		purged := []int64{}
+		if c.find(c.lastID) < 0 {
+			purged = append(purged, c.lastID)
+		}
+		live = append(live, &filePurge{Chat: chat, Purged: purged, LastTime: c.lastTime})
+	}
+	if err := s.journal.rewrite(live...); err != nil {
+		return err
+	}
+	s.records = len(live)
+	return nil
+}
+
+func (s *fileStore) Close() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.journal.Close()
+}
+
+// copyMessages shallow-copies msgs so callers cannot modify stored messages.
+func copyMessages(msgs []*rpc.Message) []*rpc.Message {
+	out := make([]*rpc.Message, 0, len(msgs))
+	for _, msg := range msgs {
+		cp := *msg
+		out = append(out, &cp)
+	}
+	return out
+}
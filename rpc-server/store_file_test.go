@@ -0,0 +1,124 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/TikTokTechImmersion/assignment_demo_2023/rpc-server/kitex_gen/rpc"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFileStore(t *testing.T) {
+	testMessageStore(t, func(t *testing.T) MessageStore {
+		store, err := OpenFileStore(filepath.Join(t.TempDir(), "messages.log"))
+		if err != nil {
+			t.Fatal(err)
+		}
+		return store
+	})
+}
+
+func TestFileStore_Reopen(t *testing.T) {
+	ctx := context.Background()
+	path := filepath.Join(t.TempDir(), "messages.log")
+	store, err := OpenFileStore(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.NoError(t, store.Append(ctx, &rpc.Message{Chat: "a:b", Text: "kept", SendTime: 1}))
+	assert.NoError(t, store.Close())
+
+	// Simulate a crash in the middle of writing the next message.
+	f, err := os.OpenFile(path, os.O_APPEND|os.O_WRONLY, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = f.WriteString(`{"Chat":"a:b","Te`)
+	assert.NoError(t, err)
+	assert.NoError(t, f.Close())
+
+	store, err = OpenFileStore(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer store.Close()
+	msg := &rpc.Message{Chat: "a:b", Text: "new", SendTime: 1}
+	assert.NoError(t, store.Append(ctx, msg))
+	assert.Equal(t, int64(2), msg.ID, "IDs must keep increasing after a restart")
+	assert.Equal(t, int64(2), msg.SendTime)
+	got, err := store.Range(ctx, "a:b", 0, 0, false)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"kept", "new"}, texts(got))
+}
+
+func TestFileStore_ReopenUpdated(t *testing.T) {
+	ctx := context.Background()
+	path := filepath.Join(t.TempDir(), "messages.log")
+	store, err := OpenFileStore(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	msg := &rpc.Message{Chat: "a:b", Text: "1", SendTime: 1}
+	assert.NoError(t, store.Append(ctx, msg))
+	msg.Text, msg.Edited = "2", true
+	assert.NoError(t, replaceMessage(ctx, store, msg))
+	assert.NoError(t, store.Close())
+
+	store, err = OpenFileStore(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer store.Close()
+	next := &rpc.Message{Chat: "a:b", Text: "3", SendTime: 1}
+	assert.NoError(t, store.Append(ctx, next))
+	assert.Equal(t, int64(3), next.SendTime, "send times must move past update times after a restart")
+	got, err := store.Changes(ctx, "a:b", 0, 0)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"2", "3"}, texts(got))
+	history, err := store.History(ctx, "a:b", 1)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"1"}, texts(history))
+}
+
+func TestFileStore_ReopenPurged(t *testing.T) {
+	ctx := context.Background()
+	path := filepath.Join(t.TempDir(), "messages.log")
+	store, err := OpenFileStore(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	msgs := make([]*rpc.Message, 0, fileRewriteMin+2)
+	for i := 0; i < fileRewriteMin+2; i++ {
+		msgs = append(msgs, &rpc.Message{Chat: "a:b", Text: "1", SendTime: 1})
+	}
+	assert.NoError(t, store.Append(ctx, msgs...))
+	// The first purge leaves too few stale records to rewrite the journal,
+	// the second one rewrites it.
+	purged, err := store.Purge(ctx, PurgeQuery{Chat: "a:b", MaxID: 1})
+	assert.NoError(t, err)
+	assert.Len(t, purged, 1)
+	assert.Equal(t, int64(1), purged[0].ID)
+	purged, err = store.Purge(ctx, PurgeQuery{Chat: "a:b", SentBefore: msgs[len(msgs)-1].SendTime + 1})
+	assert.NoError(t, err)
+	assert.Len(t, purged, fileRewriteMin+1)
+	assert.NoError(t, store.Close())
+	b, err := os.ReadFile(path)
+	assert.NoError(t, err)
+	assert.Less(t, bytes.Count(b, []byte("\n")), 10)
+
+	store, err = OpenFileStore(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer store.Close()
+	got, err := store.Range(ctx, "a:b", 0, 0, false)
+	assert.NoError(t, err)
+	assert.Empty(t, got)
+	next := &rpc.Message{Chat: "a:b", Text: "3", SendTime: 1}
+	assert.NoError(t, store.Append(ctx, next))
+	assert.Equal(t, int64(fileRewriteMin+3), next.ID, "IDs of purged messages must not be reused after a restart")
+	assert.Greater(t, next.SendTime, msgs[len(msgs)-1].SendTime)
+}
@@ -0,0 +1,494 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"strconv"
+
+	"github.com/TikTokTechImmersion/assignment_demo_2023/rpc-server/kitex_gen/rpc"
+	"github.com/redis/go-redis/v9"
+)
+
+// redisStore is a MessageStore backed by Redis. Each chat uses these keys,
+// sharing a hash tag so that they live in the same cluster slot:
+//
+//	im:{<chat>}:seq          hash with the "id" of the last message and the
+//	                         "time" of the last send or update
+//	im:{<chat>}:time         sorted set of message IDs scored by send time
+//	im:{<chat>}:upd          sorted set of message IDs scored by update time
+//	im:{<chat>}:exp          sorted set of the IDs of disappearing messages
+//	                         scored by expire time
+//	im:{<chat>}:msgs         hash of JSON encoded messages by ID
+//	im:{<chat>}:hist:<id>    list of the JSON encoded previous versions of a
+//	                         message
+//	im:{<chat>}:thread:<id>  sorted set of the IDs of the replies of the
+//	                         thread started by a message, scored by send time
+type redisStore struct {
+	rdb redis.UniversalClient
+}
+
+// NewRedisStore creates a MessageStore on top of rdb.
+func NewRedisStore(rdb redis.UniversalClient) MessageStore {
+	return &redisStore{rdb: rdb}
+}
+
+func redisSeqKey(chat string) string  { return "im:{" + chat + "}:seq" }
+func redisTimeKey(chat string) string { return "im:{" + chat + "}:time" }
+func redisUpdKey(chat string) string  { return "im:{" + chat + "}:upd" }
+func redisExpKey(chat string) string  { return "im:{" + chat + "}:exp" }
+func redisMsgsKey(chat string) string { return "im:{" + chat + "}:msgs" }
+func redisHistKey(chat string, id int64) string {
+	return "im:{" + chat + "}:hist:" + strconv.FormatInt(id, 10)
+}
+func redisThreadKey(chat string, root int64) string {
+	return "im:{" + chat + "}:thread:" + strconv.FormatInt(root, 10)
+}
+
+// Append assigns the IDs and send times of the messages of each chat inside
+// an optimistic transaction on its sequence key, so that concurrent
+// rpc-server replicas never assign the same ID twice.
+func (s *redisStore) Append(ctx context.Context, msgs ...*rpc.Message) error {
+	chats, byChat := groupByChat(msgs)
+	for _, chat := range chats {
+		if err := s.appendChat(ctx, chat, byChat[chat]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (s *redisStore) appendChat(ctx context.Context, chat string, msgs []*rpc.Message) error {
+	seqKey := redisSeqKey(chat)
+	sendTimes := make([]int64, 0, len(msgs))
+	for _, msg := range msgs {
+		sendTimes = append(sendTimes, msg.SendTime)
+	}
+	for {
+		err := s.rdb.Watch(ctx, func(tx *redis.Tx) error {
+			last, err := tx.HMGet(ctx, seqKey, "id", "time").Result()
+			if err != nil {
+				return err
+			}
+			// Restore the send times moved by a previous attempt.
+			for i, msg := range msgs {
+				msg.SendTime = sendTimes[i]
+			}
+			stampMessages(msgs, redisInt(last[0]), redisInt(last[1]))
+			scores := make([]redis.Z, 0, len(msgs))
+			updates := make([]redis.Z, 0, len(msgs))
+			bodies := make([]interface{}, 0, 2*len(msgs))
+			replies := make(map[int64][]redis.Z)
+			var expiries []redis.Z
+			for _, msg := range msgs {
+				body, err := json.Marshal(msg)
+				if err != nil {
+					return err
+				}
+				id := strconv.FormatInt(msg.ID, 10)
+				scores = append(scores, redis.Z{Score: float64(msg.SendTime), Member: id})
+				updates = append(updates, redis.Z{Score: float64(msg.UpdateTime), Member: id})
+				bodies = append(bodies, id, body)
+				if msg.RootID != 0 {
+					replies[msg.RootID] = append(replies[msg.RootID], redis.Z{Score: float64(msg.SendTime), Member: id})
+				}
+				if msg.ExpireTime != 0 {
+					expiries = append(expiries, redis.Z{Score: float64(msg.ExpireTime), Member: id})
+				}
+			}
+			tail := msgs[len(msgs)-1]
+			_, err = tx.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+				pipe.HSet(ctx, seqKey, "id", tail.ID, "time", tail.SendTime)
+				pipe.ZAdd(ctx, redisTimeKey(chat), scores...)
+				pipe.ZAdd(ctx, redisUpdKey(chat), updates...)
+				pipe.HSet(ctx, redisMsgsKey(chat), bodies...)
+				for root, scores := range replies {
+					pipe.ZAdd(ctx, redisThreadKey(chat, root), scores...)
+				}
+				if len(expiries) > 0 {
+					pipe.ZAdd(ctx, redisExpKey(chat), expiries...)
+				}
+				return nil
+			})
+			return err
+		}, seqKey)
+		if err != redis.TxFailedErr {
+			return err
+		}
+	}
+}
+
+func (s *redisStore) Update(ctx context.Context, chat string, id, updateTime int64, change func(msg *rpc.Message) error) (*rpc.Message, error) {
+	return s.modify(ctx, chat, id, updateTime, updateChange(change))
+}
+
+func (s *redisStore) React(ctx context.Context, chat string, id int64, emoji, user string, add bool, updateTime int64) (*rpc.Message, error) {
+	return s.modify(ctx, chat, id, updateTime, reactChange(emoji, user, add))
+}
+
+// modify stores the message of chat with ID id as changed by change, called
+// with the stored version, as Update specifies. It runs inside an optimistic
+// transaction on the sequence key and the message, which also orders it with
+// concurrent sends and updates, so change may be called more than once. It
+// returns the stored version, left as is if change reports no change.
+func (s *redisStore) modify(ctx context.Context, chat string, id, updateTime int64,
+	change func(msg *rpc.Message) (bool, error)) (*rpc.Message, error) {
+	seqKey, msgsKey := redisSeqKey(chat), redisMsgsKey(chat)
+	field := strconv.FormatInt(id, 10)
+	var stored *rpc.Message
+	for {
+		err := s.rdb.Watch(ctx, func(tx *redis.Tx) error {
+			body, err := tx.HGet(ctx, msgsKey, field).Bytes()
+			if err == redis.Nil {
+				return errMessageNotFound
+			} else if err != nil {
+				return err
+			}
+			msg := new(rpc.Message)
+			if err := json.Unmarshal(body, msg); err != nil {
+				return err
+			}
+			if msg.Deleted {
+				return errMessageDeleted
+			}
+			old := *msg
+			if changed, err := change(msg); err != nil {
+				return err
+			} else if !changed {
+				stored = &old
+				return nil
+			}
+			lastTime, err := tx.HGet(ctx, seqKey, "time").Int64()
+			if err != nil && err != redis.Nil {
+				return err
+			}
+			msg.UpdateTime = updateTime
+			if msg.UpdateTime <= lastTime {
+				msg.UpdateTime = lastTime + 1
+			}
+			next, err := json.Marshal(msg)
+			if err != nil {
+				return err
+			}
+			old.Reactions = nil
+			version, err := json.Marshal(&old)
+			if err != nil {
+				return err
+			}
+			_, err = tx.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+				pipe.HSet(ctx, seqKey, "time", msg.UpdateTime)
+				pipe.ZAdd(ctx, redisUpdKey(chat), redis.Z{Score: float64(msg.UpdateTime), Member: field})
+				pipe.HSet(ctx, msgsKey, field, next)
+				if msg.Deleted {
+					pipe.Del(ctx, redisHistKey(chat, id))
+				} else if msg.Text != old.Text {
+					pipe.RPush(ctx, redisHistKey(chat, id), version)
+				}
+				return nil
+			})
+			stored = msg
+			return err
+		}, seqKey, msgsKey)
+		if err != redis.TxFailedErr {
+			return stored, err
+		}
+	}
+}
+
+// redisInt parses a value returned by HMGET, nil being zero.
+func redisInt(v interface{}) int64 {
+	s, _ := v.(string)
+	n, _ := strconv.ParseInt(s, 10, 64)
+	return n
+}
+
+// redisRangeScript reads a page of message IDs from the sorted set KEYS[1]
+// and returns their bodies from the hash KEYS[2] in one round trip.
+// ARGV holds the minimum and maximum score, the limit and the direction.
+var redisRangeScript = redis.NewScript(`
+local ids
+if ARGV[4] == "1" then
+	ids = redis.call("ZREVRANGEBYSCORE", KEYS[1], ARGV[2], ARGV[1], "LIMIT", 0, ARGV[3])
+else
+	ids = redis.call("ZRANGEBYSCORE", KEYS[1], ARGV[1], ARGV[2], "LIMIT", 0, ARGV[3])
+end
+if #ids == 0 then
+	return {}
+end
+return redis.call("HMGET", KEYS[2], unpack(ids))
+`)
+
+func (s *redisStore) Range(ctx context.Context, chat string, cursor int64, limit int, reverse bool) ([]*rpc.Message, error) {
+	q := RangeQuery{Chat: chat, Cursor: cursor, Limit: limit, Reverse: reverse}
+	bodies, err := redisRangeScript.Run(ctx, s.rdb, redisRangeKeys(q), redisRangeArgs(q)...).Slice()
+	if err == redis.Nil {
+		return nil, nil
+	} else if err != nil {
+		return nil, err
+	}
+	return redisMessages(bodies)
+}
+
+// MultiRange runs the range script for every query in a single pipeline,
+// loading the script first if Redis does not know it yet.
+func (s *redisStore) MultiRange(ctx context.Context, queries []RangeQuery) ([][]*rpc.Message, error) {
+	cmds, err := s.multiRange(ctx, queries, redisRangeScript.EvalSha)
+	if err != nil && redis.HasErrorPrefix(err, "NOSCRIPT") {
+		cmds, err = s.multiRange(ctx, queries, redisRangeScript.Eval)
+	}
+	if err != nil && err != redis.Nil {
+		return nil, err
+	}
+	pages := make([][]*rpc.Message, 0, len(queries))
+	for _, cmd := range cmds {
+		bodies, err := cmd.Slice()
+		if err != nil && err != redis.Nil {
+			return nil, err
+		}
+		msgs, err := redisMessages(bodies)
+		if err != nil {
+			return nil, err
+		}
+		pages = append(pages, msgs)
+	}
+	return pages, nil
+}
+
+func (s *redisStore) multiRange(ctx context.Context, queries []RangeQuery,
+	eval func(context.Context, redis.Scripter, []string, ...interface{}) *redis.Cmd) ([]*redis.Cmd, error) {
+	cmds := make([]*redis.Cmd, 0, len(queries))
+	_, err := s.rdb.Pipelined(ctx, func(pipe redis.Pipeliner) error {
+		for _, q := range queries {
+			cmds = append(cmds, eval(ctx, pipe, redisRangeKeys(q), redisRangeArgs(q)...))
+		}
+		return nil
+	})
+	return cmds, err
+}
+
+func redisRangeKeys(q RangeQuery) []string {
+	return []string{redisTimeKey(q.Chat), redisMsgsKey(q.Chat)}
+}
+
+// redisRangeArgs returns the ARGV of redisRangeScript running q.
+func redisRangeArgs(q RangeQuery) []interface{} {
+	from, to, direction := strconv.FormatInt(q.Cursor, 10), "+inf", "0"
+	if q.Reverse {
+		from, to, direction = "-inf", strconv.FormatInt(q.Cursor, 10), "1"
+		if q.Cursor <= 0 {
+			to = "+inf"
+		}
+	}
+	limit := q.Limit
+	if limit <= 0 {
+		limit = -1
+	}
+	return []interface{}{from, to, limit, direction}
+}
+
+// redisMessages decodes the message bodies returned by redisRangeScript.
+func redisMessages(bodies []interface{}) ([]*rpc.Message, error) {
+	msgs := make([]*rpc.Message, 0, len(bodies))
+	for _, body := range bodies {
+		b, ok := body.(string)
+		if !ok {
+			continue
+		}
+		msg := new(rpc.Message)
+		if err := json.Unmarshal([]byte(b), msg); err != nil {
+			return nil, err
+		}
+		msgs = append(msgs, msg)
+	}
+	return msgs, nil
+}
+
+func (s *redisStore) Thread(ctx context.Context, chat string, root, cursor int64, limit int) ([]*rpc.Message, error) {
+	q := RangeQuery{Chat: chat, Cursor: cursor, Limit: limit}
+	keys := []string{redisThreadKey(chat, root), redisMsgsKey(chat)}
+	bodies, err := redisRangeScript.Run(ctx, s.rdb, keys, redisRangeArgs(q)...).Slice()
+	if err == redis.Nil {
+		return nil, nil
+	} else if err != nil {
+		return nil, err
+	}
+	return redisMessages(bodies)
+}
+
+func (s *redisStore) Get(ctx context.Context, chat string, id int64) (*rpc.Message, error) {
+	body, err := s.rdb.HGet(ctx, redisMsgsKey(chat), strconv.FormatInt(id, 10)).Bytes()
+	if err == redis.Nil {
+		return nil, errMessageNotFound
+	} else if err != nil {
+		return nil, err
+	}
+	msg := new(rpc.Message)
+	if err := json.Unmarshal(body, msg); err != nil {
+		return nil, err
+	}
+	return msg, nil
+}
+
+// redisNextScript returns the body from the hash KEYS[2] of the first message
+// ID greater than ARGV[1] in the sorted set KEYS[1], found by a binary search
+// on ranks, IDs increasing along with send times.
+var redisNextScript = redis.NewScript(`
+local id = tonumber(ARGV[1])
+local lo, hi = 0, redis.call("ZCARD", KEYS[1])
+while lo < hi do
+	local mid = math.floor((lo + hi) / 2)
+	if tonumber(redis.call("ZRANGE", KEYS[1], mid, mid)[1]) > id then
+		hi = mid
+	else
+		lo = mid + 1
+	end
+end
+local ids = redis.call("ZRANGE", KEYS[1], lo, lo)
+if #ids == 0 then
+	return false
+end
+return redis.call("HGET", KEYS[2], ids[1])
+`)
+
+func (s *redisStore) Next(ctx context.Context, chat string, id int64) (*rpc.Message, error) {
+	keys := []string{redisTimeKey(chat), redisMsgsKey(chat)}
+	body, err := redisNextScript.Run(ctx, s.rdb, keys, id).Text()
+	if err == redis.Nil {
+		return nil, errMessageNotFound
+	} else if err != nil {
+		return nil, err
+	}
+	msg := new(rpc.Message)
+	if err := json.Unmarshal([]byte(body), msg); err != nil {
+		return nil, err
+	}
+	return msg, nil
+}
+
+func (s *redisStore) Changes(ctx context.Context, chat string, cursor int64, limit int) ([]*rpc.Message, error) {
+	q := RangeQuery{Chat: chat, Cursor: cursor, Limit: limit}
+	keys := []string{redisUpdKey(chat), redisMsgsKey(chat)}
+	bodies, err := redisRangeScript.Run(ctx, s.rdb, keys, redisRangeArgs(q)...).Slice()
+	if err == redis.Nil {
+		return nil, nil
+	} else if err != nil {
+		return nil, err
+	}
+	return redisMessages(bodies)
+}
+
+func (s *redisStore) History(ctx context.Context, chat string, id int64) ([]*rpc.Message, error) {
+	exists, err := s.rdb.HExists(ctx, redisMsgsKey(chat), strconv.FormatInt(id, 10)).Result()
+	if err != nil {
+		return nil, err
+	} else if !exists {
+		return nil, errMessageNotFound
+	}
+	bodies, err := s.rdb.LRange(ctx, redisHistKey(chat, id), 0, -1).Result()
+	if err != nil {
+		return nil, err
+	}
+	versions := make([]interface{}, 0, len(bodies))
+	for _, body := range bodies {
+		versions = append(versions, body)
+	}
+	return redisMessages(versions)
+}
+
+// Purge runs inside an optimistic transaction on the sequence and message
+// keys, so that the messages are not updated while being purged.
+func (s *redisStore) Purge(ctx context.Context, q PurgeQuery) ([]*rpc.Message, error) {
+	seqKey, msgsKey := redisSeqKey(q.Chat), redisMsgsKey(q.Chat)
+	for {
+		var purged []*rpc.Message
+		err := s.rdb.Watch(ctx, func(tx *redis.Tx) error {
+			fields, err := redisExpired(ctx, tx, q)
+			if err != nil || len(fields) == 0 {
+				return err
+			}
+			bodies, err := tx.HMGet(ctx, msgsKey, fields...).Result()
+			if err != nil {
+				return err
+			}
+			msgs, err := redisMessages(bodies)
+			if err != nil {
+				return err
+			}
+			_, err = tx.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+				members := make([]interface{}, 0, len(fields))
+				for _, field := range fields {
+					members = append(members, field)
+				}
+				for _, msg := range msgs {
+					id := strconv.FormatInt(msg.ID, 10)
+					pipe.Del(ctx, redisHistKey(q.Chat, msg.ID))
+					if msg.RootID != 0 {
+						pipe.ZRem(ctx, redisThreadKey(q.Chat, msg.RootID), id)
+					}
+				}
+				pipe.ZRem(ctx, redisTimeKey(q.Chat), members...)
+				pipe.ZRem(ctx, redisUpdKey(q.Chat), members...)
+				pipe.ZRem(ctx, redisExpKey(q.Chat), members...)
+				pipe.HDel(ctx, msgsKey, fields...)
+				return nil
+			})
+			if err == nil {
+				purged = msgs
+			}
+			return err
+		}, seqKey, msgsKey)
+		if err != redis.TxFailedErr {
+			return purged, err
+		}
+	}
+}
+
+// redisExpired returns the IDs of the messages expired by q.
+func redisExpired(ctx context.Context, tx *redis.Tx, q PurgeQuery) ([]string, error) {
+	var ids []string
+	if q.SentBefore > 0 {
+		old, err := tx.ZRangeByScore(ctx, redisTimeKey(q.Chat), &redis.ZRangeBy{
+			Min: "-inf",
+			Max: "(" + strconv.FormatInt(q.SentBefore, 10),
+		}).Result()
+		if err != nil {
+			return nil, err
+		}
+		ids = append(ids, old...)
+	}
+	if q.MaxID > 0 {
+		// IDs increase along with send times, those up to MaxID come first.
+		first, err := tx.ZRange(ctx, redisTimeKey(q.Chat), 0, q.MaxID-1).Result()
+		if err != nil {
+			return nil, err
+		}
+		for _, id := range first {
+			if n, _ := strconv.ParseInt(id, 10, 64); n <= q.MaxID {
+				ids = append(ids, id)
+			}
+		}
+	}
+	if q.Now > 0 {
+		gone, err := tx.ZRangeByScore(ctx, redisExpKey(q.Chat), &redis.ZRangeBy{
+			Min: "-inf",
+			Max: strconv.FormatInt(q.Now, 10),
+		}).Result()
+		if err != nil {
+			return nil, err
+		}
+		ids = append(ids, gone...)
+	}
+	// Drop the duplicates.
+	seen := make(map[string]bool, len(ids))
+	fields := make([]string, 0, len(ids))
+	for _, id := range ids {
+		if !seen[id] {
+			seen[id] = true
+			fields = append(fields, id)
+		}
+	}
+	return fields, nil
+}
+
+func (s *redisStore) Close() error {
+	return s.rdb.Close()
+}
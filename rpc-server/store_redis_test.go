@@ -0,0 +1,15 @@
+package main
+
+import (
+	"testing"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/redis/go-redis/v9"
+)
+
+func TestRedisStore(t *testing.T) {
+	testMessageStore(t, func(t *testing.T) MessageStore {
+		mr := miniredis.RunT(t)
+		return NewRedisStore(redis.NewClient(&redis.Options{Addr: mr.Addr()}))
+	})
+}
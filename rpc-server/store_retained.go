@@ -0,0 +1,163 @@
+package main
+
+import (
+	"context"
+	"time"
+
+	"github.com/TikTokTechImmersion/assignment_demo_2023/rpc-server/kitex_gen/rpc"
+)
+
+// retainedStore is a MessageStore hiding the messages expired by the
+// retention policies of their chats, which may be read until the compactor
+// purges them. Pages are read on until they are full, so that expired
+// messages do not shorten them.
+type retainedStore struct {
+	MessageStore
+	retention RetentionStore
+}
+
+func (s *retainedStore) query(ctx context.Context, chat string) (PurgeQuery, error) {
+	return purgeQuery(ctx, s.MessageStore, s.retention, chat, time.Now().UnixMicro())
+}
+
+func (s *retainedStore) Range(ctx context.Context, chat string, cursor int64, limit int, reverse bool) ([]*rpc.Message, error) {
+	q, err := s.query(ctx, chat)
+	if err != nil {
+		return nil, err
+	}
+	if !reverse && cursor < q.SentBefore {
+		cursor = q.SentBefore
+	}
+	return fillPage(q, limit, func(limit int) ([]*rpc.Message, error) {
+		return s.MessageStore.Range(ctx, chat, cursor, limit, reverse)
+	}, func(last *rpc.Message) bool {
+		if !reverse {
+			cursor = last.SendTime + 1
+			return true
+		}
+		// Older messages are all expired once one is by send time or ID.
+		cursor = last.SendTime - 1
+		return cursor > 0 && last.SendTime >= q.SentBefore && last.ID > q.MaxID
+	})
+}
+
+func (s *retainedStore) MultiRange(ctx context.Context, queries []RangeQuery) ([][]*rpc.Message, error) {
+	pages, err := s.MessageStore.MultiRange(ctx, queries)
+	if err != nil {
+		return nil, err
+	}
+	for i, page := range pages {
+		q, err := s.query(ctx, queries[i].Chat)
+		if err != nil {
+			return nil, err
+		}
+		for _, msg := range page {
+			if q.expired(msg) {
+				// Read the page again, on until it is full.
+				r := queries[i]
+				if pages[i], err = s.Range(ctx, r.Chat, r.Cursor, r.Limit, r.Reverse); err != nil {
+					return nil, err
+				}
+				break
+			}
+		}
+	}
+	return pages, nil
+}
+
+func (s *retainedStore) Thread(ctx context.Context, chat string, root, cursor int64, limit int) ([]*rpc.Message, error) {
+	q, err := s.query(ctx, chat)
+	if err != nil {
+		return nil, err
+	}
+	if cursor < q.SentBefore {
+		cursor = q.SentBefore
+	}
+	return fillPage(q, limit, func(limit int) ([]*rpc.Message, error) {
+		return s.MessageStore.Thread(ctx, chat, root, cursor, limit)
+	}, func(last *rpc.Message) bool {
+		cursor = last.SendTime + 1
+		return true
+	})
+}
+
+func (s *retainedStore) Get(ctx context.Context, chat string, id int64) (*rpc.Message, error) {
+	msg, err := s.MessageStore.Get(ctx, chat, id)
+	if err != nil {
+		return nil, err
+	}
+	q, err := s.query(ctx, chat)
+	if err != nil {
+		return nil, err
+	}
+	if q.expired(msg) {
+		return nil, errMessageNotFound
+	}
+	return msg, nil
+}
+
+// Next reads on from the first message after id if it is expired, those
+// expired by send time or ID all preceding those left.
+func (s *retainedStore) Next(ctx context.Context, chat string, id int64) (*rpc.Message, error) {
+	msg, err := s.MessageStore.Next(ctx, chat, id)
+	if err != nil {
+		return nil, err
+	}
+	q, err := s.query(ctx, chat)
+	if err != nil {
+		return nil, err
+	}
+	if !q.expired(msg) {
+		return msg, nil
+	}
+	msgs, err := s.Range(ctx, chat, msg.SendTime+1, 1, false)
+	if err != nil {
+		return nil, err
+	}
+	if len(msgs) == 0 {
+		return nil, errMessageNotFound
+	}
+	return msgs[0], nil
+}
+
+func (s *retainedStore) Changes(ctx context.Context, chat string, cursor int64, limit int) ([]*rpc.Message, error) {
+	q, err := s.query(ctx, chat)
+	if err != nil {
+		return nil, err
+	}
+	// Messages are updated after being sent, those updated before
+	// q.SentBefore are expired too.
+	if cursor < q.SentBefore {
+		cursor = q.SentBefore
+	}
+	return fillPage(q, limit, func(limit int) ([]*rpc.Message, error) {
+		return s.MessageStore.Changes(ctx, chat, cursor, limit)
+	}, func(last *rpc.Message) bool {
+		cursor = last.UpdateTime + 1
+		return true
+	})
+}
+
+// fillPage reads pages of up to limit messages with read, dropping those
+// expired by q, until it has limit messages or the messages run out. next
+// moves the cursor of read past the last message read, and reports whether
+// messages not expired may follow it.
+func fillPage(q PurgeQuery, limit int, read func(limit int) ([]*rpc.Message, error),
+	next func(last *rpc.Message) bool) ([]*rpc.Message, error) {
+	var page []*rpc.Message
+	for {
+		want := limit - len(page)
+		msgs, err := read(want)
+		if err != nil {
+			return nil, err
+		}
+		for _, msg := range msgs {
+			if !q.expired(msg) {
+				page = append(page, msg)
+			}
+		}
+		if limit <= 0 || len(msgs) < want || len(page) == limit || !next(msgs[len(msgs)-1]) {
+			return page, nil
+		}
+	}
+}
@@ -0,0 +1,469 @@
+package main
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"sort"
+	"strings"
+
+	"github.com/TikTokTechImmersion/assignment_demo_2023/rpc-server/kitex_gen/rpc"
+	"github.com/go-sql-driver/mysql"
+)
+
+// sqlMessageColumns are the columns of the messages table read by
+// scanSQLMessage, in order.
+const sqlMessageColumns = `chat, id, send_time, sender, text, update_time, edited, deleted, reply_to, root_id, reactions, content_type, attachment, expire_time`
+
+// sqlScanner is a row of *sql.Rows or a *sql.Row.
+type sqlScanner interface {
+	Scan(dest ...interface{}) error
+}
+
+// scanSQLMessage reads a message from a row holding sqlMessageColumns,
+// preceded by the columns read into prefix.
+func scanSQLMessage(row sqlScanner, prefix ...interface{}) (*rpc.Message, error) {
+	msg := new(rpc.Message)
+	var reactions, attachment []byte
+	dest := append(prefix, &msg.Chat, &msg.ID, &msg.SendTime, &msg.Sender, &msg.Text,
+		&msg.UpdateTime, &msg.Edited, &msg.Deleted, &msg.ReplyTo, &msg.RootID, &reactions,
+		&msg.Type, &attachment, &msg.ExpireTime)
+	if err := row.Scan(dest...); err != nil {
+		return nil, err
+	}
+	if len(reactions) > 0 {
+		if err := json.Unmarshal(reactions, &msg.Reactions); err != nil {
+			return nil, err
+		}
+	}
+	if len(attachment) > 0 {
+		if err := json.Unmarshal(attachment, &msg.Attachment); err != nil {
+			return nil, err
+		}
+	}
+	return msg, nil
+}
+
+// sqlReactions returns the value of the reactions column of msg.
+func sqlReactions(msg *rpc.Message) (interface{}, error) {
+	if len(msg.Reactions) == 0 {
+		return nil, nil
+	}
+	b, err := json.Marshal(msg.Reactions)
+	if err != nil {
+		return nil, err
+	}
+	return string(b), nil
+}
+
+// sqlAttachment returns the value of the attachment column of msg.
+func sqlAttachment(msg *rpc.Message) (interface{}, error) {
+	if msg.Attachment == nil {
+		return nil, nil
+	}
+	b, err := json.Marshal(msg.Attachment)
+	if err != nil {
+		return nil, err
+	}
+	return string(b), nil
+}
+
+// sqlStore is a MessageStore backed by a relational database through
+// database/sql. Its queries are portable between MySQL and SQLite.
+type sqlStore struct {
+	db *sql.DB
+}
+
+// OpenSQLStore creates a MessageStore on top of db, migrating its schema to
+// the latest version first.
+func OpenSQLStore(ctx context.Context, db *sql.DB) (MessageStore, error) {
+	if err := migrateSQL(ctx, db); err != nil {
+		return nil, err
+	}
+	return &sqlStore{db: db}, nil
+}
+
+// Append assigns the IDs and send times of msgs from the chat_sequences rows
+// of their chats, which the updates lock until the transaction ends.
+func (s *sqlStore) Append(ctx context.Context, msgs ...*rpc.Message) error {
+	sendTimes := make([]int64, 0, len(msgs))
+	for _, msg := range msgs {
+		sendTimes = append(sendTimes, msg.SendTime)
+	}
+	// The first messages of a chat race to insert its sequence row, the losers
+	// retry and update the row inserted by the winner. Other errors are not
+	// retried.
+	for attempt := 1; ; attempt++ {
+		for i, msg := range msgs {
+			msg.SendTime = sendTimes[i]
+		}
+		err := s.append(ctx, msgs)
+		if !errors.Is(err, errSQLSequenceTaken) || attempt == sqlAppendAttempts || ctx.Err() != nil {
+			return err
+		}
+	}
+}
+
+// sqlAppendAttempts is the number of times Append tries to store messages
+// losing the race to insert the sequence row of their chat.
+const sqlAppendAttempts = 3
+
+// errSQLSequenceTaken is returned when another transaction inserted the
+// sequence row of a chat first.
+var errSQLSequenceTaken = errors.New("sequence row inserted concurrently")
+
+// isSQLDuplicateKey reports whether err is a duplicate key error of MySQL
+// or a unique constraint error of SQLite, which is matched on its message so
+// that the rpc-server does not link the SQLite driver.
+func isSQLDuplicateKey(err error) bool {
+	var mysqlErr *mysql.MySQLError
+	if errors.As(err, &mysqlErr) {
+		return mysqlErr.Number == 1062 // ER_DUP_ENTRY
+	}
+	return err != nil && strings.Contains(err.Error(), "UNIQUE constraint failed")
+}
+
+// sqlInsertBatch is the number of messages inserted per statement, keeping
+// the placeholders of a statement within the limits of MySQL and SQLite.
+const sqlInsertBatch = 500
+
+func (s *sqlStore) append(ctx context.Context, msgs []*rpc.Message) error {
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	chats, byChat := groupByChat(msgs)
+	// Lock the sequence rows in the same order in every transaction, so that
+	// concurrent batches cannot deadlock.
+	sort.Strings(chats)
+	for _, chat := range chats {
+		if err := s.stamp(ctx, tx, chat, byChat[chat]); err != nil {
+			return err
+		}
+	}
+	for start := 0; start < len(msgs); start += sqlInsertBatch {
+		end := start + sqlInsertBatch
+		if end > len(msgs) {
+			end = len(msgs)
+		}
+		query := `INSERT INTO messages (` + sqlMessageColumns + `) VALUES ` +
+			strings.TrimSuffix(strings.Repeat(`(?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?), `, end-start), `, `)
+		args := make([]interface{}, 0, 14*(end-start))
+		for _, msg := range msgs[start:end] {
+			reactions, err := sqlReactions(msg)
+			if err != nil {
+				return err
+			}
+			attachment, err := sqlAttachment(msg)
+			if err != nil {
+				return err
+			}
+			args = append(args, msg.Chat, msg.ID, msg.SendTime, msg.Sender, msg.Text,
+				msg.UpdateTime, msg.Edited, msg.Deleted, msg.ReplyTo, msg.RootID, reactions,
+				msg.Type, attachment, msg.ExpireTime)
+		}
+		if _, err := tx.ExecContext(ctx, query, args...); err != nil {
+			return err
+		}
+	}
+	return tx.Commit()
+}
+
+// stamp assigns the IDs and send times of msgs, all of chat, and reserves
+// them in the sequence row of chat.
+func (s *sqlStore) stamp(ctx context.Context, tx *sql.Tx, chat string, msgs []*rpc.Message) error {
+	n := int64(len(msgs))
+	res, err := tx.ExecContext(ctx, `UPDATE chat_sequences SET last_id = last_id + ? WHERE chat = ?`, n, chat)
+	if err != nil {
+		return err
+	}
+	if affected, err := res.RowsAffected(); err != nil {
+		return err
+	} else if affected == 0 {
+		_, err = tx.ExecContext(ctx, `INSERT INTO chat_sequences (chat, last_id, last_time) VALUES (?, ?, 0)`, chat, n)
+		if isSQLDuplicateKey(err) {
+			return fmt.Errorf("%w: %v", errSQLSequenceTaken, err)
+		} else if err != nil {
+			return err
+		}
+	}
+	var lastID, lastTime int64
+	err = tx.QueryRowContext(ctx, `SELECT last_id, last_time FROM chat_sequences WHERE chat = ?`, chat).
+		Scan(&lastID, &lastTime)
+	if err != nil {
+		return err
+	}
+	stampMessages(msgs, lastID-n, lastTime)
+	_, err = tx.ExecContext(ctx, `UPDATE chat_sequences SET last_time = ? WHERE chat = ?`,
+		msgs[len(msgs)-1].SendTime, chat)
+	return err
+}
+
+func (s *sqlStore) Update(ctx context.Context, chat string, id, updateTime int64, change func(msg *rpc.Message) error) (*rpc.Message, error) {
+	return s.modify(ctx, chat, id, updateTime, updateChange(change))
+}
+
+func (s *sqlStore) React(ctx context.Context, chat string, id int64, emoji, user string, add bool, updateTime int64) (*rpc.Message, error) {
+	return s.modify(ctx, chat, id, updateTime, reactChange(emoji, user, add))
+}
+
+// modify stores the message of chat with ID id as changed by change, called
+// with the stored version, as Update specifies. The sequence row of the chat
+// is locked first, so that it is ordered with concurrent sends and updates.
+// It returns the stored version, left as is if change reports no change.
+func (s *sqlStore) modify(ctx context.Context, chat string, id, updateTime int64,
+	change func(msg *rpc.Message) (bool, error)) (*rpc.Message, error) {
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	_, err = tx.ExecContext(ctx, `UPDATE chat_sequences
+		SET last_time = CASE WHEN last_time < ? THEN ? ELSE last_time + 1 END
+		WHERE chat = ?`, updateTime, updateTime, chat)
+	if err != nil {
+		return nil, err
+	}
+	err = tx.QueryRowContext(ctx, `SELECT last_time FROM chat_sequences WHERE chat = ?`, chat).Scan(&updateTime)
+	if err == sql.ErrNoRows {
+		return nil, errMessageNotFound
+	} else if err != nil {
+		return nil, err
+	}
+	row := tx.QueryRowContext(ctx, `SELECT `+sqlMessageColumns+` FROM messages WHERE chat = ? AND id = ?`, chat, id)
+	msg, err := scanSQLMessage(row)
+	if err == sql.ErrNoRows {
+		return nil, errMessageNotFound
+	} else if err != nil {
+		return nil, err
+	} else if msg.Deleted {
+		return nil, errMessageDeleted
+	}
+	old := *msg
+	if changed, err := change(msg); err != nil {
+		return nil, err
+	} else if !changed {
+		// Rolling back leaves the sequence as it was.
+		return &old, nil
+	}
+	if msg.Deleted {
+		_, err = tx.ExecContext(ctx, `DELETE FROM message_history WHERE chat = ? AND id = ?`, chat, id)
+	} else if msg.Text != old.Text {
+		_, err = tx.ExecContext(ctx, `INSERT INTO message_history (chat, id, update_time, text, edited) VALUES (?, ?, ?, ?, ?)`,
+			chat, id, old.UpdateTime, old.Text, old.Edited)
+	}
+	if err != nil {
+		return nil, err
+	}
+	reactions, err := sqlReactions(msg)
+	if err != nil {
+		return nil, err
+	}
+	attachment, err := sqlAttachment(msg)
+	if err != nil {
+		return nil, err
+	}
+	_, err = tx.ExecContext(ctx, `UPDATE messages SET text = ?, update_time = ?, edited = ?, deleted = ?, reactions = ?, attachment = ?
+		WHERE chat = ? AND id = ?`, msg.Text, updateTime, msg.Edited, msg.Deleted, reactions, attachment, chat, id)
+	if err != nil {
+		return nil, err
+	}
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+	msg.UpdateTime = updateTime
+	return msg, nil
+}
+
+func (s *sqlStore) Range(ctx context.Context, chat string, cursor int64, limit int, reverse bool) ([]*rpc.Message, error) {
+	pages, err := s.MultiRange(ctx, []RangeQuery{{Chat: chat, Cursor: cursor, Limit: limit, Reverse: reverse}})
+	if err != nil {
+		return nil, err
+	}
+	return pages[0], nil
+}
+
+// MultiRange runs the queries as one UNION ALL of subqueries, tagging their
+// rows with the index of the query.
+func (s *sqlStore) MultiRange(ctx context.Context, queries []RangeQuery) ([][]*rpc.Message, error) {
+	pages := make([][]*rpc.Message, len(queries))
+	if len(queries) == 0 {
+		return pages, nil
+	}
+	var (
+		parts []string
+		args  []interface{}
+	)
+	for i, q := range queries {
+		sub := `SELECT ` + sqlMessageColumns + ` FROM messages WHERE chat = ? AND send_time >= ? ORDER BY send_time`
+		cursor := q.Cursor
+		if q.Reverse {
+			sub = `SELECT ` + sqlMessageColumns + ` FROM messages WHERE chat = ? AND send_time <= ? ORDER BY send_time DESC`
+			if cursor <= 0 {
+				cursor = math.MaxInt64
+			}
+		}
+		args = append(args, q.Chat, cursor)
+		if q.Limit > 0 {
+			sub += ` LIMIT ?`
+			args = append(args, q.Limit)
+		}
+		parts = append(parts, fmt.Sprintf(`SELECT %d AS q, t%d.* FROM (%s) AS t%[2]d`, i, i, sub))
+	}
+	rows, err := s.db.QueryContext(ctx, strings.Join(parts, ` UNION ALL `), args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	for rows.Next() {
+		var q int
+		msg, err := scanSQLMessage(rows, &q)
+		if err != nil {
+			return nil, err
+		}
+		pages[q] = append(pages[q], msg)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	// A UNION does not keep the order of its subqueries.
+	for i, q := range queries {
+		page, reverse := pages[i], q.Reverse
+		sort.Slice(page, func(i, j int) bool { return (page[i].SendTime < page[j].SendTime) != reverse })
+	}
+	return pages, nil
+}
+
+func (s *sqlStore) Thread(ctx context.Context, chat string, root, cursor int64, limit int) ([]*rpc.Message, error) {
+	query := `SELECT ` + sqlMessageColumns + ` FROM messages WHERE chat = ? AND root_id = ? AND send_time >= ? ORDER BY send_time`
+	args := []interface{}{chat, root, cursor}
+	if limit > 0 {
+		query += ` LIMIT ?`
+		args = append(args, limit)
+	}
+	return s.query(ctx, query, args...)
+}
+
+func (s *sqlStore) Get(ctx context.Context, chat string, id int64) (*rpc.Message, error) {
+	row := s.db.QueryRowContext(ctx, `SELECT `+sqlMessageColumns+` FROM messages WHERE chat = ? AND id = ?`, chat, id)
+	msg, err := scanSQLMessage(row)
+	if err == sql.ErrNoRows {
+		return nil, errMessageNotFound
+	} else if err != nil {
+		return nil, err
+	}
+	return msg, nil
+}
+
+func (s *sqlStore) Next(ctx context.Context, chat string, id int64) (*rpc.Message, error) {
+	row := s.db.QueryRowContext(ctx, `SELECT `+sqlMessageColumns+` FROM messages WHERE chat = ? AND id > ? ORDER BY id LIMIT 1`, chat, id)
+	msg, err := scanSQLMessage(row)
+	if err == sql.ErrNoRows {
+		return nil, errMessageNotFound
+	} else if err != nil {
+		return nil, err
+	}
+	return msg, nil
+}
+
+func (s *sqlStore) Changes(ctx context.Context, chat string, cursor int64, limit int) ([]*rpc.Message, error) {
+	query := `SELECT ` + sqlMessageColumns + ` FROM messages WHERE chat = ? AND update_time >= ? ORDER BY update_time`
+	args := []interface{}{chat, cursor}
+	if limit > 0 {
+		query += ` LIMIT ?`
+		args = append(args, limit)
+	}
+	return s.query(ctx, query, args...)
+}
+
+// query runs a query selecting sqlMessageColumns and returns its messages.
+func (s *sqlStore) query(ctx context.Context, query string, args ...interface{}) ([]*rpc.Message, error) {
+	rows, err := s.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var msgs []*rpc.Message
+	for rows.Next() {
+		msg, err := scanSQLMessage(rows)
+		if err != nil {
+			return nil, err
+		}
+		msgs = append(msgs, msg)
+	}
+	return msgs, rows.Err()
+}
+
+// History rebuilds the previous versions of the message from its current
+// version and the texts in message_history.
+func (s *sqlStore) History(ctx context.Context, chat string, id int64) ([]*rpc.Message, error) {
+	current, err := s.Get(ctx, chat, id)
+	if err != nil {
+		return nil, err
+	}
+	rows, err := s.db.QueryContext(ctx, `SELECT update_time, text, edited FROM message_history
+		WHERE chat = ? AND id = ? ORDER BY update_time`, chat, id)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var versions []*rpc.Message
+	for rows.Next() {
+		version := *current
+		if err := rows.Scan(&version.UpdateTime, &version.Text, &version.Edited); err != nil {
+			return nil, err
+		}
+		version.Deleted, version.Reactions = false, nil
+		versions = append(versions, &version)
+	}
+	return versions, rows.Err()
+}
+
+func (s *sqlStore) Purge(ctx context.Context, q PurgeQuery) ([]*rpc.Message, error) {
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	where := `chat = ? AND (send_time < ? OR id <= ? OR (expire_time > 0 AND expire_time <= ?))`
+	args := []interface{}{q.Chat, q.SentBefore, q.MaxID, q.Now}
+	rows, err := tx.QueryContext(ctx, `SELECT `+sqlMessageColumns+` FROM messages WHERE `+where+` ORDER BY send_time`, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var msgs []*rpc.Message
+	for rows.Next() {
+		msg, err := scanSQLMessage(rows)
+		if err != nil {
+			return nil, err
+		}
+		msgs = append(msgs, msg)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	if len(msgs) == 0 {
+		return nil, nil
+	}
+	_, err = tx.ExecContext(ctx, `DELETE FROM message_history WHERE chat = ? AND id IN (SELECT id FROM messages WHERE `+where+`)`,
+		append([]interface{}{q.Chat}, args...)...)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := tx.ExecContext(ctx, `DELETE FROM messages WHERE `+where, args...); err != nil {
+		return nil, err
+	}
+	return msgs, tx.Commit()
+}
+
+func (s *sqlStore) Close() error {
+	return s.db.Close()
+}
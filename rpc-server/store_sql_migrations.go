@@ -0,0 +1,137 @@
+package main
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+)
+
+// sqlMigration is one version of the schema of sqlStore. Its statements must
+// be valid for both MySQL and SQLite.
+type sqlMigration struct {
+	version    int
+	statements []string
+}
+
+// sqlMigrations lists the schema versions in order. Released migrations must
+// never change, new versions are appended instead.
+var sqlMigrations = []sqlMigration{
+	{
+		version: 1,
+		statements: []string{
+			`CREATE TABLE messages (
+				chat      VARCHAR(255) NOT NULL,
+				id        BIGINT       NOT NULL,
+				send_time BIGINT       NOT NULL,
+				sender    VARCHAR(255) NOT NULL,
+				text      TEXT         NOT NULL,
+				PRIMARY KEY (chat, id)
+			)`,
+			`CREATE UNIQUE INDEX idx_messages_chat_send_time ON messages (chat, send_time)`,
+			`CREATE TABLE chat_sequences (
+				chat      VARCHAR(255) NOT NULL,
+				last_id   BIGINT       NOT NULL,
+				last_time BIGINT       NOT NULL,
+				PRIMARY KEY (chat)
+			)`,
+		},
+	},
+	{
+		version: 2,
+		statements: []string{
+			`ALTER TABLE messages ADD COLUMN update_time BIGINT NOT NULL DEFAULT 0`,
+			`ALTER TABLE messages ADD COLUMN edited BOOLEAN NOT NULL DEFAULT FALSE`,
+			`ALTER TABLE messages ADD COLUMN deleted BOOLEAN NOT NULL DEFAULT FALSE`,
+			`UPDATE messages SET update_time = send_time`,
+			`CREATE UNIQUE INDEX idx_messages_chat_update_time ON messages (chat, update_time)`,
+			`CREATE TABLE message_history (
+				chat        VARCHAR(255) NOT NULL,
+				id          BIGINT       NOT NULL,
+				update_time BIGINT       NOT NULL,
+				text        TEXT         NOT NULL,
+				edited      BOOLEAN      NOT NULL,
+				PRIMARY KEY (chat, id, update_time)
+			)`,
+		},
+	},
+	{
+		version: 3,
+		statements: []string{
+			`ALTER TABLE messages ADD COLUMN reply_to BIGINT NOT NULL DEFAULT 0`,
+			`ALTER TABLE messages ADD COLUMN root_id BIGINT NOT NULL DEFAULT 0`,
+			`CREATE INDEX idx_messages_chat_root_send_time ON messages (chat, root_id, send_time)`,
+		},
+	},
+	{
+		version: 4,
+		statements: []string{
+			// JSON encoded reactions, NULL if none.
+			`ALTER TABLE messages ADD COLUMN reactions TEXT`,
+		},
+	},
+	{
+		version: 5,
+		statements: []string{
+			`ALTER TABLE messages ADD COLUMN content_type INT NOT NULL DEFAULT 0`,
+			// JSON encoded attachment, NULL if none.
+			`ALTER TABLE messages ADD COLUMN attachment TEXT`,
+		},
+	},
+	{
+		version: 6,
+		statements: []string{
+			`ALTER TABLE messages ADD COLUMN expire_time BIGINT NOT NULL DEFAULT 0`,
+			`CREATE INDEX idx_messages_chat_expire_time ON messages (chat, expire_time)`,
+		},
+	},
+}
+
+// migrateSQL brings the schema of db to the latest version, applying the
+// missing migrations in order. Applied versions are recorded in the
+// schema_migrations table.
+func migrateSQL(ctx context.Context, db *sql.DB) error {
+	_, err := db.ExecContext(ctx, `CREATE TABLE IF NOT EXISTS schema_migrations (
+		version    INT    NOT NULL,
+		applied_at BIGINT NOT NULL,
+		PRIMARY KEY (version)
+	)`)
+	if err != nil {
+		return err
+	}
+	var current int
+	err = db.QueryRowContext(ctx, `SELECT COALESCE(MAX(version), 0) FROM schema_migrations`).Scan(&current)
+	if err != nil {
+		return err
+	}
+	for _, m := range sqlMigrations {
+		if m.version <= current {
+			continue
+		}
+		if err := applySQLMigration(ctx, db, m); err != nil {
+			return fmt.Errorf("migration %d: %w", m.version, err)
+		}
+	}
+	return nil
+}
+
+// applySQLMigration runs m in a transaction. MySQL commits DDL statements
+// implicitly though, so a failed migration may have to be repaired by hand.
+func applySQLMigration(ctx context.Context, db *sql.DB, m sqlMigration) error {
+	tx, err := db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+	for _, stmt := range m.statements {
+		if _, err := tx.ExecContext(ctx, stmt); err != nil {
+			return err
+		}
+	}
+	_, err = tx.ExecContext(ctx, `INSERT INTO schema_migrations (version, applied_at) VALUES (?, ?)`,
+		m.version, time.Now().UnixMicro())
+	if err != nil {
+		return err
+	}
+	return tx.Commit()
+}
@@ -0,0 +1,72 @@
+package main
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"path/filepath"
+	"testing"
+
+	"github.com/go-sql-driver/mysql"
+	_ "github.com/mattn/go-sqlite3"
+	"github.com/stretchr/testify/assert"
+)
+
+// openTestSQLite opens an SQLite database standing in for MySQL.
+func openTestSQLite(t *testing.T) *sql.DB {
+	db, err := sql.Open("sqlite3", filepath.Join(t.TempDir(), "im.db"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	// SQLite allows a single writer, let transactions queue up in database/sql
+	// instead of failing with "database is locked".
+	db.SetMaxOpenConns(1)
+	return db
+}
+
+func TestSQLStore(t *testing.T) {
+	testMessageStore(t, func(t *testing.T) MessageStore {
+		store, err := OpenSQLStore(context.Background(), openTestSQLite(t))
+		if err != nil {
+			t.Fatal(err)
+		}
+		return store
+	})
+}
+
+func TestMigrateSQL(t *testing.T) {
+	ctx := context.Background()
+	db := openTestSQLite(t)
+	defer db.Close()
+
+	// Migrating twice must be a no-op the second time.
+	assert.NoError(t, migrateSQL(ctx, db))
+	assert.NoError(t, migrateSQL(ctx, db))
+
+	var versions int
+	assert.NoError(t, db.QueryRow(`SELECT COUNT(*) FROM schema_migrations`).Scan(&versions))
+	assert.Equal(t, len(sqlMigrations), versions)
+	var latest int
+	assert.NoError(t, db.QueryRow(`SELECT MAX(version) FROM schema_migrations`).Scan(&latest))
+	assert.Equal(t, sqlMigrations[len(sqlMigrations)-1].version, latest)
+}
+
+func TestIsSQLDuplicateKey(t *testing.T) {
+	ctx := context.Background()
+	db := openTestSQLite(t)
+	defer db.Close()
+	assert.NoError(t, migrateSQL(ctx, db))
+
+	insert := `INSERT INTO chat_sequences (chat, last_id, last_time) VALUES ('a:b', 1, 0)`
+	_, err := db.ExecContext(ctx, insert)
+	assert.NoError(t, err)
+	_, err = db.ExecContext(ctx, insert)
+	assert.True(t, isSQLDuplicateKey(err))
+	_, err = db.ExecContext(ctx, `INSERT INTO chat_sequences (chat) VALUES (NULL)`)
+	assert.False(t, isSQLDuplicateKey(err))
+
+	assert.True(t, isSQLDuplicateKey(&mysql.MySQLError{Number: 1062, Message: "Duplicate entry 'a:b' for key 'PRIMARY'"}))
+	assert.False(t, isSQLDuplicateKey(&mysql.MySQLError{Number: 1213, Message: "Deadlock found when trying to get lock"}))
+	assert.False(t, isSQLDuplicateKey(errors.New("driver: bad connection")))
+	assert.False(t, isSQLDuplicateKey(nil))
+}
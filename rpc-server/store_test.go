@@ -0,0 +1,546 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"testing"
+
+	"github.com/TikTokTechImmersion/assignment_demo_2023/rpc-server/kitex_gen/rpc"
+	"github.com/stretchr/testify/assert"
+)
+
+// testMessageStore runs the tests every MessageStore implementation must pass.
+// open returns a new empty store, which is closed by testMessageStore.
+func testMessageStore(t *testing.T, open func(t *testing.T) MessageStore) {
+	tests := []struct {
+		name string
+		test func(t *testing.T, store MessageStore)
+	}{
+		{name: "Range", test: testMessageStoreRange},
+		{name: "Append", test: testMessageStoreAppend},
+		{name: "ConcurrentAppend", test: testMessageStoreConcurrentAppend},
+		{name: "Get", test: testMessageStoreGet},
+		{name: "Next", test: testMessageStoreNext},
+		{name: "AppendBatch", test: testMessageStoreAppendBatch},
+		{name: "MultiRange", test: testMessageStoreMultiRange},
+		{name: "Update", test: testMessageStoreUpdate},
+		{name: "ConcurrentUpdate", test: testMessageStoreConcurrentUpdate},
+		{name: "Thread", test: testMessageStoreThread},
+		{name: "React", test: testMessageStoreReact},
+		{name: "Attachment", test: testMessageStoreAttachment},
+		{name: "Purge", test: testMessageStorePurge},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			store := open(t)
+			defer store.Close()
+			tt.test(t, store)
+		})
+	}
+}
+
+// replaceMessage replaces the text, flags and attachment of the stored
+// message with the chat and ID of msg by those of msg, at msg.UpdateTime, and
+// sets msg to the stored version.
+func replaceMessage(ctx context.Context, store MessageStore, msg *rpc.Message) error {
+	stored, err := store.Update(ctx, msg.Chat, msg.ID, msg.UpdateTime, func(prev *rpc.Message) error {
+		prev.Text, prev.Edited, prev.Deleted, prev.Attachment = msg.Text, msg.Edited, msg.Deleted, msg.Attachment
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	*msg = *stored
+	return nil
+}
+
+func testMessageStoreRange(t *testing.T, store MessageStore) {
+	ctx := context.Background()
+	for _, msg := range []*rpc.Message{
+		{Chat: "a:b", Text: "1", SendTime: 10},
+		{Chat: "a:b", Text: "2", SendTime: 20},
+		{Chat: "a:b", Text: "3", SendTime: 30},
+		{Chat: "a:c", Text: "x", SendTime: 15},
+	} {
+		assert.NoError(t, store.Append(ctx, msg))
+	}
+
+	tests := []struct {
+		name    string
+		chat    string
+		cursor  int64
+		limit   int
+		reverse bool
+		want    []string
+	}{
+		{name: "all", chat: "a:b", want: []string{"1", "2", "3"}},
+		{name: "inclusive cursor", chat: "a:b", cursor: 20, want: []string{"2", "3"}},
+		{name: "limit", chat: "a:b", limit: 2, want: []string{"1", "2"}},
+		{name: "reverse from latest", chat: "a:b", reverse: true, want: []string{"3", "2", "1"}},
+		{name: "reverse with cursor", chat: "a:b", cursor: 20, limit: 1, reverse: true, want: []string{"2"}},
+		{name: "other chat", chat: "a:c", want: []string{"x"}},
+		{name: "unknown chat", chat: "b:c", want: []string{}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := store.Range(ctx, tt.chat, tt.cursor, tt.limit, tt.reverse)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, texts(got))
+		})
+	}
+}
+
+func testMessageStoreAppend(t *testing.T, store MessageStore) {
+	ctx := context.Background()
+
+	msgs := []*rpc.Message{
+		{Chat: "a:b", SendTime: 10},
+		{Chat: "a:b", SendTime: 10},
+		{Chat: "a:b", SendTime: 5},
+		{Chat: "a:c", SendTime: 7},
+		{Chat: "a:b", SendTime: 20},
+	}
+	for _, msg := range msgs {
+		assert.NoError(t, store.Append(ctx, msg))
+	}
+	var ids, sendTimes []int64
+	for _, msg := range msgs {
+		ids = append(ids, msg.ID)
+		sendTimes = append(sendTimes, msg.SendTime)
+	}
+	assert.Equal(t, []int64{1, 2, 3, 1, 4}, ids)
+	assert.Equal(t, []int64{10, 11, 12, 7, 20}, sendTimes)
+}
+
+func testMessageStoreConcurrentAppend(t *testing.T, store MessageStore) {
+	ctx := context.Background()
+	const n = 20
+	var wg sync.WaitGroup
+	for i := 0; i < n; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			assert.NoError(t, store.Append(ctx, &rpc.Message{Chat: "a:b", SendTime: 1}))
+		}()
+	}
+	wg.Wait()
+
+	msgs, err := store.Range(ctx, "a:b", 0, 0, false)
+	assert.NoError(t, err)
+	assert.Len(t, msgs, n)
+	for i, msg := range msgs {
+		assert.Equal(t, int64(i+1), msg.ID)
+		assert.Equal(t, int64(i+1), msg.SendTime)
+	}
+}
+
+func testMessageStoreGet(t *testing.T, store MessageStore) {
+	ctx := context.Background()
+	for _, text := range []string{"1", "2", "3"} {
+		assert.NoError(t, store.Append(ctx, &rpc.Message{Chat: "a:b", Text: text, Sender: "a", SendTime: 10}))
+	}
+
+	msg, err := store.Get(ctx, "a:b", 2)
+	assert.NoError(t, err)
+	assert.Equal(t, &rpc.Message{Chat: "a:b", Text: "2", Sender: "a", SendTime: 11, ID: 2, UpdateTime: 11}, msg)
+	_, err = store.Get(ctx, "a:b", 4)
+	assert.ErrorIs(t, err, errMessageNotFound)
+	_, err = store.Get(ctx, "a:c", 1)
+	assert.ErrorIs(t, err, errMessageNotFound)
+}
+
+func testMessageStoreNext(t *testing.T, store MessageStore) {
+	ctx := context.Background()
+	for i := 1; i <= 5; i++ {
+		assert.NoError(t, store.Append(ctx, &rpc.Message{Chat: "a:b", Text: fmt.Sprint(i), SendTime: int64(10 * i), ExpireTime: int64(100 * (i % 2))}))
+	}
+	_, err := store.Purge(ctx, PurgeQuery{Chat: "a:b", Now: 100})
+	assert.NoError(t, err)
+
+	tests := []struct {
+		id   int64
+		want string
+	}{
+		{id: 0, want: "2"},
+		{id: 1, want: "2"},
+		{id: 2, want: "4"},
+		{id: 3, want: "4"},
+		{id: 4},
+		{id: 5},
+	}
+	for _, tt := range tests {
+		msg, err := store.Next(ctx, "a:b", tt.id)
+		if tt.want == "" {
+			assert.ErrorIs(t, err, errMessageNotFound, "after %d", tt.id)
+			continue
+		}
+		if assert.NoError(t, err, "after %d", tt.id) {
+			assert.Equal(t, tt.want, msg.Text, "after %d", tt.id)
+		}
+	}
+	_, err = store.Next(ctx, "a:c", 0)
+	assert.ErrorIs(t, err, errMessageNotFound)
+}
+
+func testMessageStoreAppendBatch(t *testing.T, store MessageStore) {
+	ctx := context.Background()
+	assert.NoError(t, store.Append(ctx, &rpc.Message{Chat: "a:b", Text: "0", SendTime: 10}))
+
+	msgs := []*rpc.Message{
+		{Chat: "a:b", Text: "1", SendTime: 10},
+		{Chat: "a:c", Text: "x", SendTime: 10},
+		{Chat: "a:b", Text: "2", SendTime: 10},
+		{Chat: "a:b", Text: "3", SendTime: 30},
+	}
+	assert.NoError(t, store.Append(ctx, msgs...))
+	var ids, sendTimes []int64
+	for _, msg := range msgs {
+		ids = append(ids, msg.ID)
+		sendTimes = append(sendTimes, msg.SendTime)
+	}
+	assert.Equal(t, []int64{2, 1, 3, 4}, ids)
+	assert.Equal(t, []int64{11, 10, 12, 30}, sendTimes)
+
+	got, err := store.Range(ctx, "a:b", 0, 0, false)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"0", "1", "2", "3"}, texts(got))
+	// The sequence continues after the batch.
+	msg := &rpc.Message{Chat: "a:b", SendTime: 1}
+	assert.NoError(t, store.Append(ctx, msg))
+	assert.Equal(t, int64(5), msg.ID)
+	assert.Equal(t, int64(31), msg.SendTime)
+}
+
+func testMessageStoreMultiRange(t *testing.T, store MessageStore) {
+	ctx := context.Background()
+	assert.NoError(t, store.Append(ctx,
+		&rpc.Message{Chat: "a:b", Text: "1", SendTime: 10},
+		&rpc.Message{Chat: "a:b", Text: "2", SendTime: 20},
+		&rpc.Message{Chat: "a:b", Text: "3", SendTime: 30},
+		&rpc.Message{Chat: "a:c", Text: "x", SendTime: 15},
+	))
+
+	pages, err := store.MultiRange(ctx, []RangeQuery{
+		{Chat: "a:b", Cursor: 20},
+		{Chat: "a:c"},
+		{Chat: "a:b", Limit: 2, Reverse: true},
+		{Chat: "b:c"},
+		{Chat: "a:b", Limit: 1},
+	})
+	assert.NoError(t, err)
+	var got [][]string
+	for _, page := range pages {
+		got = append(got, texts(page))
+	}
+	assert.Equal(t, [][]string{{"2", "3"}, {"x"}, {"3", "2"}, {}, {"1"}}, got)
+
+	pages, err = store.MultiRange(ctx, nil)
+	assert.NoError(t, err)
+	assert.Empty(t, pages)
+}
+
+func testMessageStoreUpdate(t *testing.T, store MessageStore) {
+	ctx := context.Background()
+	for _, text := range []string{"1", "2", "3"} {
+		assert.NoError(t, store.Append(ctx, &rpc.Message{Chat: "a:b", Text: text, Sender: "a", SendTime: 10}))
+	}
+
+	// Update times move past the send times, like send times do.
+	edit := &rpc.Message{Chat: "a:b", Text: "1b", Sender: "a", SendTime: 10, ID: 1, UpdateTime: 5, Edited: true}
+	assert.NoError(t, replaceMessage(ctx, store, edit))
+	assert.Equal(t, int64(13), edit.UpdateTime)
+	edit = &rpc.Message{Chat: "a:b", Text: "1c", Sender: "a", SendTime: 10, ID: 1, UpdateTime: 20, Edited: true}
+	assert.NoError(t, replaceMessage(ctx, store, edit))
+	assert.Equal(t, int64(20), edit.UpdateTime)
+	del := &rpc.Message{Chat: "a:b", Sender: "a", SendTime: 11, ID: 2, UpdateTime: 20, Deleted: true}
+	assert.NoError(t, replaceMessage(ctx, store, del))
+	assert.Equal(t, int64(21), del.UpdateTime)
+	assert.ErrorIs(t, replaceMessage(ctx, store, &rpc.Message{Chat: "a:b", ID: 4, UpdateTime: 30}), errMessageNotFound)
+	assert.ErrorIs(t, replaceMessage(ctx, store, &rpc.Message{Chat: "a:c", ID: 1, UpdateTime: 30}), errMessageNotFound)
+
+	// The next message is sent after the updates.
+	sent := &rpc.Message{Chat: "a:b", Text: "4", Sender: "a", SendTime: 10}
+	assert.NoError(t, store.Append(ctx, sent))
+	assert.Equal(t, int64(22), sent.SendTime)
+
+	msgs, err := store.Range(ctx, "a:b", 0, 0, false)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"1c", "", "3", "4"}, texts(msgs))
+
+	t.Run("Changes", func(t *testing.T) {
+		tests := []struct {
+			name   string
+			cursor int64
+			limit  int
+			want   []string
+		}{
+			{name: "all", want: []string{"3", "1c", "", "4"}},
+			{name: "inclusive cursor", cursor: 20, want: []string{"1c", "", "4"}},
+			{name: "limit", cursor: 13, limit: 2, want: []string{"1c", ""}},
+			{name: "past the end", cursor: 23, want: []string{}},
+		}
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				got, err := store.Changes(ctx, "a:b", tt.cursor, tt.limit)
+				assert.NoError(t, err)
+				assert.Equal(t, tt.want, texts(got))
+			})
+		}
+		got, err := store.Changes(ctx, "a:c", 0, 0)
+		assert.NoError(t, err)
+		assert.Empty(t, got)
+	})
+
+	t.Run("History", func(t *testing.T) {
+		history, err := store.History(ctx, "a:b", 1)
+		assert.NoError(t, err)
+		assert.Equal(t, []*rpc.Message{
+			{Chat: "a:b", Text: "1", Sender: "a", SendTime: 10, ID: 1, UpdateTime: 10},
+			{Chat: "a:b", Text: "1b", Sender: "a", SendTime: 10, ID: 1, UpdateTime: 13, Edited: true},
+		}, history)
+		// The history of deleted messages is dropped along with their text.
+		history, err = store.History(ctx, "a:b", 2)
+		assert.NoError(t, err)
+		assert.Empty(t, history)
+		history, err = store.History(ctx, "a:b", 3)
+		assert.NoError(t, err)
+		assert.Empty(t, history)
+		_, err = store.History(ctx, "a:b", 5)
+		assert.ErrorIs(t, err, errMessageNotFound)
+	})
+}
+
+func testMessageStoreConcurrentUpdate(t *testing.T, store MessageStore) {
+	ctx := context.Background()
+	assert.NoError(t, store.Append(ctx, &rpc.Message{Chat: "a:b", Text: "1", Sender: "a", SendTime: 10}))
+
+	// Rejected changes leave the message as is.
+	errRejected := errors.New("rejected")
+	_, err := store.Update(ctx, "a:b", 1, 20, func(msg *rpc.Message) error {
+		msg.Text = "x"
+		return errRejected
+	})
+	assert.ErrorIs(t, err, errRejected)
+	msg, err := store.Get(ctx, "a:b", 1)
+	assert.NoError(t, err)
+	assert.Equal(t, "1", msg.Text)
+	assert.Equal(t, int64(10), msg.UpdateTime)
+
+	// Edits racing with a delete never bring the deleted text back.
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			deleted := i == 5
+			_, err := store.Update(ctx, "a:b", 1, 20, func(msg *rpc.Message) error {
+				if deleted {
+					msg.Text, msg.Deleted = "", true
+				} else {
+					msg.Text, msg.Edited = fmt.Sprintf("edit %d", i), true
+				}
+				return nil
+			})
+			if err != nil {
+				assert.ErrorIs(t, err, errMessageDeleted)
+			}
+		}(i)
+	}
+	wg.Wait()
+	msg, err = store.Get(ctx, "a:b", 1)
+	assert.NoError(t, err)
+	assert.True(t, msg.Deleted)
+	assert.Empty(t, msg.Text)
+	history, err := store.History(ctx, "a:b", 1)
+	assert.NoError(t, err)
+	assert.Empty(t, history)
+
+	_, err = store.Update(ctx, "a:b", 1, 30, func(msg *rpc.Message) error { return nil })
+	assert.ErrorIs(t, err, errMessageDeleted)
+	_, err = store.React(ctx, "a:b", 1, "+1", "a", true, 30)
+	assert.ErrorIs(t, err, errMessageDeleted)
+}
+
+func testMessageStoreThread(t *testing.T, store MessageStore) {
+	ctx := context.Background()
+	for _, msg := range []*rpc.Message{
+		{Chat: "a:b", Text: "1", SendTime: 10},
+		{Chat: "a:b", Text: "2", SendTime: 20},
+		{Chat: "a:b", Text: "1.1", SendTime: 30, ReplyTo: 1, RootID: 1},
+		{Chat: "a:b", Text: "2.1", SendTime: 40, ReplyTo: 2, RootID: 2},
+		{Chat: "a:b", Text: "1.2", SendTime: 50, ReplyTo: 3, RootID: 1},
+		{Chat: "a:c", Text: "x.1", SendTime: 60, ReplyTo: 1, RootID: 1},
+	} {
+		assert.NoError(t, store.Append(ctx, msg))
+	}
+	assert.NoError(t, store.Append(ctx, &rpc.Message{Chat: "a:b", Text: "1.3", SendTime: 70, ReplyTo: 1, RootID: 1},
+		&rpc.Message{Chat: "a:b", Text: "2.2", SendTime: 70, ReplyTo: 2, RootID: 2}))
+
+	tests := []struct {
+		name   string
+		chat   string
+		root   int64
+		cursor int64
+		limit  int
+		want   []string
+	}{
+		{name: "all", chat: "a:b", root: 1, want: []string{"1.1", "1.2", "1.3"}},
+		{name: "inclusive cursor", chat: "a:b", root: 1, cursor: 50, want: []string{"1.2", "1.3"}},
+		{name: "limit", chat: "a:b", root: 1, limit: 2, want: []string{"1.1", "1.2"}},
+		{name: "other thread", chat: "a:b", root: 2, want: []string{"2.1", "2.2"}},
+		{name: "other chat", chat: "a:c", root: 1, want: []string{"x.1"}},
+		{name: "no replies", chat: "a:b", root: 3, want: []string{}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := store.Thread(ctx, tt.chat, tt.root, tt.cursor, tt.limit)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, texts(got))
+		})
+	}
+
+	msg, err := store.Get(ctx, "a:b", 5)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(3), msg.ReplyTo)
+	assert.Equal(t, int64(1), msg.RootID)
+}
+
+func testMessageStoreReact(t *testing.T, store MessageStore) {
+	ctx := context.Background()
+	for _, text := range []string{"1", "2"} {
+		assert.NoError(t, store.Append(ctx, &rpc.Message{Chat: "a:b", Text: text, Sender: "a", SendTime: 10}))
+	}
+
+	msg, err := store.React(ctx, "a:b", 1, "+1", "a", true, 5)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(12), msg.UpdateTime)
+	_, err = store.React(ctx, "a:b", 1, "+1", "b", true, 20)
+	assert.NoError(t, err)
+	_, err = store.React(ctx, "a:b", 1, "heart", "b", true, 20)
+	assert.NoError(t, err)
+	// Adding a reaction twice leaves the message as is.
+	msg, err = store.React(ctx, "a:b", 1, "+1", "b", true, 30)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(21), msg.UpdateTime)
+	assert.Equal(t, []*rpc.Reaction{
+		{Emoji: "+1", Count: 2, Users: []string{"a", "b"}},
+		{Emoji: "heart", Count: 1, Users: []string{"b"}},
+	}, msg.Reactions)
+	msg, err = store.React(ctx, "a:b", 1, "+1", "a", false, 30)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(30), msg.UpdateTime)
+	msg, err = store.React(ctx, "a:b", 1, "heart", "b", false, 30)
+	assert.NoError(t, err)
+	assert.Equal(t, []*rpc.Reaction{{Emoji: "+1", Count: 1, Users: []string{"b"}}}, msg.Reactions)
+	_, err = store.React(ctx, "a:b", 3, "+1", "a", true, 40)
+	assert.ErrorIs(t, err, errMessageNotFound)
+
+	// Reactions are kept by edits, and surface in ranges and changes.
+	edit := &rpc.Message{Chat: "a:b", Text: "1b", Sender: "a", SendTime: 10, ID: 1, UpdateTime: 40, Edited: true}
+	assert.NoError(t, replaceMessage(ctx, store, edit))
+	assert.Equal(t, msg.Reactions, edit.Reactions)
+	msgs, err := store.Range(ctx, "a:b", 0, 1, false)
+	assert.NoError(t, err)
+	assert.Equal(t, msg.Reactions, msgs[0].Reactions)
+	msgs, err = store.Changes(ctx, "a:b", 32, 0)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"1b"}, texts(msgs))
+	assert.Equal(t, msg.Reactions, msgs[0].Reactions)
+	history, err := store.History(ctx, "a:b", 1)
+	assert.NoError(t, err)
+	assert.Len(t, history, 1)
+	assert.Nil(t, history[0].Reactions)
+
+	// Deleted messages lose their reactions.
+	del := &rpc.Message{Chat: "a:b", Sender: "a", SendTime: 10, ID: 1, UpdateTime: 50, Deleted: true}
+	assert.NoError(t, replaceMessage(ctx, store, del))
+	msg, err = store.Get(ctx, "a:b", 1)
+	assert.NoError(t, err)
+	assert.Nil(t, msg.Reactions)
+}
+
+func testMessageStoreAttachment(t *testing.T, store MessageStore) {
+	ctx := context.Background()
+	attachment := &rpc.Attachment{Key: "k", Name: "cat.png", MimeType: "image/png", Size: 3, Checksum: "abc"}
+	assert.NoError(t, store.Append(ctx, &rpc.Message{Chat: "a:b", Text: "1", Sender: "a", SendTime: 10,
+		Type: rpc.ContentType_IMAGE, Attachment: attachment}))
+
+	msg, err := store.Get(ctx, "a:b", 1)
+	assert.NoError(t, err)
+	assert.Equal(t, rpc.ContentType_IMAGE, msg.Type)
+	assert.Equal(t, attachment, msg.Attachment)
+	msgs, err := store.Range(ctx, "a:b", 0, 0, false)
+	assert.NoError(t, err)
+	assert.Equal(t, attachment, msgs[0].Attachment)
+
+	// Deleted messages lose their attachment, not their type.
+	msg.Text, msg.Attachment, msg.Deleted, msg.UpdateTime = "", nil, true, 20
+	assert.NoError(t, replaceMessage(ctx, store, msg))
+	msg, err = store.Get(ctx, "a:b", 1)
+	assert.NoError(t, err)
+	assert.Equal(t, rpc.ContentType_IMAGE, msg.Type)
+	assert.Nil(t, msg.Attachment)
+}
+
+func testMessageStorePurge(t *testing.T, store MessageStore) {
+	ctx := context.Background()
+	assert.NoError(t, store.Append(ctx,
+		&rpc.Message{Chat: "a:b", Text: "1", SendTime: 10},
+		&rpc.Message{Chat: "a:b", Text: "2", SendTime: 20},
+		&rpc.Message{Chat: "a:b", Text: "3", SendTime: 30, ReplyTo: 1, RootID: 1},
+		&rpc.Message{Chat: "a:b", Text: "4", SendTime: 40, ExpireTime: 100, Type: rpc.ContentType_FILE, Attachment: &rpc.Attachment{Key: "k4"}},
+		&rpc.Message{Chat: "a:c", Text: "x", SendTime: 5},
+	))
+	edit := &rpc.Message{Chat: "a:b", Text: "2b", SendTime: 20, ID: 2, UpdateTime: 50, Edited: true}
+	assert.NoError(t, replaceMessage(ctx, store, edit))
+
+	tests := []struct {
+		name string
+		q    PurgeQuery
+		want []string // texts of the purged messages
+		keys []string // of their attachments
+		left []string
+	}{
+		{name: "nothing expired", q: PurgeQuery{Chat: "a:b", SentBefore: 10, Now: 99}, want: []string{}, left: []string{"1", "2b", "3", "4"}},
+		{name: "by send time", q: PurgeQuery{Chat: "a:b", SentBefore: 11}, want: []string{"1"}, left: []string{"2b", "3", "4"}},
+		{name: "by ID", q: PurgeQuery{Chat: "a:b", MaxID: 2}, want: []string{"2b"}, left: []string{"3", "4"}},
+		{name: "disappearing", q: PurgeQuery{Chat: "a:b", Now: 100}, want: []string{"4"}, keys: []string{"k4"}, left: []string{"3"}},
+		{name: "unknown chat", q: PurgeQuery{Chat: "b:c", SentBefore: 100}, want: []string{}, left: []string{"3"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			purged, err := store.Purge(ctx, tt.q)
+			assert.NoError(t, err)
+			// Purged messages are returned whole, with their attachments.
+			assert.Equal(t, tt.want, texts(purged))
+			assert.Equal(t, tt.keys, attachmentKeys(purged...))
+			msgs, err := store.Range(ctx, "a:b", 0, 0, false)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.left, texts(msgs))
+		})
+	}
+
+	// Purged messages are gone from every view of the chat, the reply of a
+	// purged thread root stays in its thread.
+	_, err := store.Get(ctx, "a:b", 1)
+	assert.ErrorIs(t, err, errMessageNotFound)
+	_, err = store.History(ctx, "a:b", 2)
+	assert.ErrorIs(t, err, errMessageNotFound)
+	msgs, err := store.Changes(ctx, "a:b", 0, 0)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"3"}, texts(msgs))
+	msgs, err = store.Thread(ctx, "a:b", 1, 0, 0)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"3"}, texts(msgs))
+	msgs, err = store.Range(ctx, "a:c", 0, 0, false)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"x"}, texts(msgs))
+
+	// IDs of purged messages are not reused.
+	msg := &rpc.Message{Chat: "a:b", Text: "5", SendTime: 10}
+	assert.NoError(t, store.Append(ctx, msg))
+	assert.Equal(t, int64(5), msg.ID)
+	assert.Greater(t, msg.SendTime, int64(50))
+}
@@ -0,0 +1,136 @@
+package main
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/golang-jwt/jwt/v4"
+)
+
+// tokenIssuer signs the access tokens of sessions with an ECDSA P-256 key,
+// whose public half is published as a JSON Web Key Set for the frontends to
+// verify them.
+type tokenIssuer struct {
+	key    *ecdsa.PrivateKey
+	kid    string // JWK thumbprint of the key, RFC 7638
+	issuer string // issuer claim of the tokens, if set
+	ttl    time.Duration
+}
+
+// accessClaims are the claims of access tokens. The subject is the user.
+type accessClaims struct {
+	jwt.RegisteredClaims
+	Session string `json:"sid"`
+}
+
+// newTokenIssuer returns a tokenIssuer signing with key tokens valid for ttl.
+func newTokenIssuer(key *ecdsa.PrivateKey, issuer string, ttl time.Duration) *tokenIssuer {
+	x, y := ecCoordinates(&key.PublicKey)
+	// Members in lexicographic order, as required for thumbprints.
+	thumbprint := sha256.Sum256([]byte(fmt.Sprintf(`{"crv":"P-256","kty":"EC","x":%q,"y":%q}`, x, y)))
+	return &tokenIssuer{
+		key:    key,
+		kid:    base64.RawURLEncoding.EncodeToString(thumbprint[:]),
+		issuer: issuer,
+		ttl:    ttl,
+	}
+}
+
+// loadTokenKey reads the PEM encoded P-256 private key at path, generating
+// and writing one first if there is none. Every rpc-server must share the
+// key, or tokens issued by one are rejected once its key set is not the one
+// loaded by the frontends anymore.
+func loadTokenKey(path string) (*ecdsa.PrivateKey, error) {
+	data, err := os.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) {
+		key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+		if err != nil {
+			return nil, err
+		}
+		der, err := x509.MarshalECPrivateKey(key)
+		if err != nil {
+			return nil, err
+		}
+		if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+			return nil, err
+		}
+		data := pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: der})
+		// Fails if another rpc-server wrote a key in the meantime, rather
+		// than replacing it.
+		f, err := os.OpenFile(path, os.O_WRONLY|os.O_CREATE|os.O_EXCL, 0o600)
+		if err != nil {
+			return nil, err
+		}
+		if _, err := f.Write(data); err != nil {
+			f.Close()
+			return nil, err
+		}
+		return key, f.Close()
+	} else if err != nil {
+		return nil, err
+	}
+	block, _ := pem.Decode(data)
+	if block == nil || block.Type != "EC PRIVATE KEY" {
+		return nil, fmt.Errorf("%s is not a PEM encoded EC private key", path)
+	}
+	key, err := x509.ParseECPrivateKey(block.Bytes)
+	if err != nil {
+		return nil, fmt.Errorf("invalid key %s: %w", path, err)
+	} else if key.Curve != elliptic.P256() {
+		return nil, fmt.Errorf("key %s is not on curve P-256", path)
+	}
+	return key, nil
+}
+
+// issue returns an access token of user in the session with ID sid, issued
+// at now.
+func (t *tokenIssuer) issue(user, sid string, now time.Time) (string, error) {
+	token := jwt.NewWithClaims(jwt.SigningMethodES256, &accessClaims{
+		RegisteredClaims: jwt.RegisteredClaims{
+			Issuer:    t.issuer,
+			Subject:   user,
+			IssuedAt:  jwt.NewNumericDate(now),
+			ExpiresAt: jwt.NewNumericDate(now.Add(t.ttl)),
+		},
+		Session: sid,
+	})
+	token.Header["kid"] = t.kid
+	return token.SignedString(t.key)
+}
+
+// keySet returns the JSON Web Key Set verifying the access tokens.
+func (t *tokenIssuer) keySet() (string, error) {
+	x, y := ecCoordinates(&t.key.PublicKey)
+	b, err := json.Marshal(map[string]interface{}{
+		"keys": []map[string]string{{
+			"kty": "EC",
+			"crv": "P-256",
+			"kid": t.kid,
+			"alg": "ES256",
+			"use": "sig",
+			"x":   x,
+			"y":   y,
+		}},
+	})
+	return string(b), err
+}
+
+// ecCoordinates returns the base64url encoded coordinates of a P-256 public
+// key, padded to 32 bytes.
+func ecCoordinates(key *ecdsa.PublicKey) (string, string) {
+	var x, y [32]byte
+	key.X.FillBytes(x[:])
+	key.Y.FillBytes(y[:])
+	return base64.RawURLEncoding.EncodeToString(x[:]), base64.RawURLEncoding.EncodeToString(y[:])
+}
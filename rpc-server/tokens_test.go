@@ -0,0 +1,64 @@
+package main
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v4"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLoadTokenKey(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "keys", "auth-key.pem")
+	key, err := loadTokenKey(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	info, err := os.Stat(path)
+	assert.NoError(t, err)
+	assert.Equal(t, os.FileMode(0o600), info.Mode().Perm())
+	again, err := loadTokenKey(path)
+	assert.NoError(t, err)
+	assert.True(t, key.Equal(again))
+
+	assert.NoError(t, os.WriteFile(path, []byte("not a key"), 0o600))
+	_, err = loadTokenKey(path)
+	assert.Error(t, err)
+}
+
+func TestTokenIssuer(t *testing.T) {
+	key, err := loadTokenKey(filepath.Join(t.TempDir(), "auth-key.pem"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	issuer := newTokenIssuer(key, "im", time.Minute)
+	now := time.Now()
+	token, err := issuer.issue("john", "s1", now)
+	assert.NoError(t, err)
+
+	var claims accessClaims
+	parsed, err := jwt.ParseWithClaims(token, &claims, func(token *jwt.Token) (interface{}, error) {
+		return &key.PublicKey, nil
+	}, jwt.WithValidMethods([]string{"ES256"}))
+	assert.NoError(t, err)
+	assert.Equal(t, issuer.kid, parsed.Header["kid"])
+	assert.Equal(t, "john", claims.Subject)
+	assert.Equal(t, "im", claims.Issuer)
+	assert.Equal(t, "s1", claims.Session)
+	assert.Equal(t, now.Add(time.Minute).Unix(), claims.ExpiresAt.Unix())
+
+	keySet, err := issuer.keySet()
+	assert.NoError(t, err)
+	var set struct {
+		Keys []map[string]string `json:"keys"`
+	}
+	assert.NoError(t, json.Unmarshal([]byte(keySet), &set))
+	if assert.Len(t, set.Keys, 1) {
+		assert.Equal(t, issuer.kid, set.Keys[0]["kid"])
+		assert.Equal(t, "ES256", set.Keys[0]["alg"])
+		assert.Len(t, set.Keys[0]["x"], 43)
+	}
+}
@@ -0,0 +1,23 @@
+package main
+
+import (
+	"context"
+	"errors"
+)
+
+var (
+	errUserNotFound = errors.New("user not found")
+	errUserExists   = errors.New("user already exists")
+)
+
+// UserStore keeps the registered users and the hashes of their passwords.
+type UserStore interface {
+	// CreateUser registers user with the password hash.
+	// It returns errUserExists if user was already registered.
+	CreateUser(ctx context.Context, user string, hash []byte) error
+	// PasswordHash returns the password hash of user.
+	// It returns errUserNotFound if user is not registered.
+	PasswordHash(ctx context.Context, user string) ([]byte, error)
+	// Close releases the resources held by the store.
+	Close() error
+}
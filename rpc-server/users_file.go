@@ -0,0 +1,71 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"sync"
+)
+
+// userRecord is a record of the user journal.
+type userRecord struct {
+	User string
+	Hash []byte
+}
+
+// fileUserStore is a UserStore backed by an append-only journal of
+// registrations on local disk, replayed into memory when opened.
+type fileUserStore struct {
+	mu      sync.RWMutex
+	journal *journal
+	hashes  map[string][]byte
+}
+
+// OpenFileUserStore opens the user journal at path, creating it if needed.
+func OpenFileUserStore(path string) (UserStore, error) {
+	s := &fileUserStore{hashes: make(map[string][]byte)}
+	j, err := openJournal(path, func(line []byte) error {
+		var rec userRecord
+		if err := json.Unmarshal(line, &rec); err != nil {
+			return err
+		}
+		if _, ok := s.hashes[rec.User]; ok {
+			return errUserExists
+		}
+		s.hashes[rec.User] = rec.Hash
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	s.journal = j
+	return s, nil
+}
+
+func (s *fileUserStore) CreateUser(ctx context.Context, user string, hash []byte) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if _, ok := s.hashes[user]; ok {
+		return errUserExists
+	}
+	if err := s.journal.append(&userRecord{User: user, Hash: hash}); err != nil {
+		return err
+	}
+	s.hashes[user] = hash
+	return nil
+}
+
+func (s *fileUserStore) PasswordHash(ctx context.Context, user string) ([]byte, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	hash, ok := s.hashes[user]
+	if !ok {
+		return nil, errUserNotFound
+	}
+	return hash, nil
+}
+
+func (s *fileUserStore) Close() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.journal.Close()
+}
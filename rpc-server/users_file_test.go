@@ -0,0 +1,32 @@
+package main
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFileUserStore(t *testing.T) {
+	ctx := context.Background()
+	path := filepath.Join(t.TempDir(), "users.log")
+	store, err := OpenFileUserStore(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.NoError(t, store.CreateUser(ctx, "john", []byte("hash")))
+	assert.ErrorIs(t, store.CreateUser(ctx, "john", []byte("other")), errUserExists)
+	assert.NoError(t, store.Close())
+
+	store, err = OpenFileUserStore(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer store.Close()
+	hash, err := store.PasswordHash(ctx, "john")
+	assert.NoError(t, err)
+	assert.Equal(t, []byte("hash"), hash)
+	_, err = store.PasswordHash(ctx, "doe")
+	assert.ErrorIs(t, err, errUserNotFound)
+}